@@ -0,0 +1,64 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "context"
+
+// BarBuilder builds bars, such as the Renko bricks, from the snapshots one at
+// a time. The bars are snapshots that have the date of the snapshot that
+// completed them, so a snapshot may complete several bars or none. Building
+// the bars one snapshot at a time allows the actions computed on the bars to
+// be mapped back to the snapshots as they arrive.
+type BarBuilder interface {
+	// Add adds the given snapshot, and returns the bars that it completed.
+	Add(snapshot *Snapshot) []*Snapshot
+}
+
+// SnapshotsAsBarsWithContext converts the snapshots in the provided channel
+// into bars using the given bar builder, and returns a new channel containing
+// the bars, supporting context cancellation.
+func SnapshotsAsBarsWithContext(ctx context.Context, snapshots <-chan *Snapshot, builder BarBuilder) <-chan *Snapshot {
+	result := make(chan *Snapshot)
+
+	go func() {
+		defer close(result)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case snapshot, ok := <-snapshots:
+				if !ok {
+					return
+				}
+
+				for _, bar := range builder.Add(snapshot) {
+					select {
+					case <-ctx.Done():
+						return
+					case result <- bar:
+					}
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// newBar returns a new bar with the date of the given snapshot, and the given
+// opening, closing, and volume, where the high and the low are the range of
+// the bar.
+func newBar(snapshot *Snapshot, opening, closing, volume float64) *Snapshot {
+	return &Snapshot{
+		Date:   snapshot.Date,
+		Open:   opening,
+		High:   max(opening, closing),
+		Low:    min(opening, closing),
+		Close:  closing,
+		Volume: volume,
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// closingSnapshots returns daily snapshots with the given closings.
+func closingSnapshots(closings ...float64) <-chan *asset.Snapshot {
+	snapshots := make([]*asset.Snapshot, len(closings))
+
+	for i, closing := range closings {
+		snapshots[i] = &asset.Snapshot{
+			Date:   time.Date(2024, time.January, i+1, 0, 0, 0, 0, time.UTC),
+			Open:   closing,
+			High:   closing,
+			Low:    closing,
+			Close:  closing,
+			Volume: 1,
+		}
+	}
+
+	return helper.SliceToChan(snapshots)
+}
+
+// barSummary is the day of the month, the opening, and the closing of a bar.
+type barSummary struct {
+	Day   int
+	Open  float64
+	Close float64
+}
+
+// summarizeBars returns the summaries of the given bars.
+func summarizeBars(bars <-chan *asset.Snapshot) []barSummary {
+	return helper.ChanToSlice(helper.Map(bars, func(bar *asset.Snapshot) barSummary {
+		return barSummary{Day: bar.Date.Day(), Open: bar.Open, Close: bar.Close}
+	}))
+}
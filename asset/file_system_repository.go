@@ -7,10 +7,13 @@ package asset
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -34,6 +37,82 @@ func NewFileSystemRepository(base string, csvOptions ...helper.CsvOption[Snapsho
 	}
 }
 
+// NewFileSystemRepositoryWithConfig initializes a file system repository using the given
+// configuration. The configuration is the base directory, optionally followed by the CSV
+// options as URL query parameters:
+//
+//	delimiter       Field delimiter, such as "%3B" for the escaped ";".
+//	comment         Comment character, such as "#".
+//	date_format     Date format, such as "2006-01-02", "unix", or "unixmilli".
+//	timezone        Time zone of the dates, such as "America/New_York".
+//	skip_malformed  Skip the malformed rows, such as "true".
+//	header.<Field>  Column header for the snapshot field, such as "header.Close=Adj Close".
+//	alias.<Field>   Alternative column header for the snapshot field. It can be repeated.
+//
+// Example:
+//
+//	repository, err := asset.NewFileSystemRepositoryWithConfig("data?delimiter=%3B&date_format=unixmilli")
+func NewFileSystemRepositoryWithConfig(config string) (*FileSystemRepository, error) {
+	base, query, _ := strings.Cut(config, "?")
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var csvOptions []helper.CsvOption[Snapshot]
+
+	for key, list := range values {
+		value := list[len(list)-1]
+
+		switch {
+		case key == "delimiter" || key == "comment":
+			r, size := utf8.DecodeRuneInString(value)
+			if r == utf8.RuneError || size != len(value) {
+				return nil, fmt.Errorf("invalid %s: %q", key, value)
+			}
+
+			if key == "delimiter" {
+				csvOptions = append(csvOptions, helper.WithCsvDelimiter[Snapshot](r))
+			} else {
+				csvOptions = append(csvOptions, helper.WithCsvComment[Snapshot](r))
+			}
+
+		case key == "date_format":
+			csvOptions = append(csvOptions, helper.WithCsvDefaultDateTimeFormat[Snapshot](value))
+
+		case key == "timezone":
+			location, err := time.LoadLocation(value)
+			if err != nil {
+				return nil, err
+			}
+
+			csvOptions = append(csvOptions, helper.WithCsvLocation[Snapshot](location))
+
+		case key == "skip_malformed":
+			skip, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+			if skip {
+				csvOptions = append(csvOptions, helper.WithCsvSkipMalformedRows[Snapshot]())
+			}
+
+		case strings.HasPrefix(key, "header."):
+			csvOptions = append(csvOptions, helper.WithCsvColumnHeader[Snapshot](strings.TrimPrefix(key, "header."), value))
+
+		case strings.HasPrefix(key, "alias."):
+			csvOptions = append(csvOptions, helper.WithCsvHeaderAliases[Snapshot](strings.TrimPrefix(key, "alias."), list...))
+
+		default:
+			return nil, fmt.Errorf("unknown file system repository option: %s", key)
+		}
+	}
+
+	return NewFileSystemRepository(base, csvOptions...), nil
+}
+
 // Assets returns the names of all assets in the repository.
 func (r *FileSystemRepository) Assets() ([]string, error) {
 	files, err := os.ReadDir(r.base)
@@ -6,6 +6,7 @@ package asset_test
 
 import (
 	"fmt"
+	"os"
 	"path"
 	"reflect"
 	"testing"
@@ -154,3 +155,68 @@ func TestFileSystemRepositoryAppend(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestFileSystemRepositoryWithConfig(t *testing.T) {
+	base := t.TempDir()
+
+	data := "# Vendor export\n" +
+		"Timestamp;Open;High;Low;Adj Close;Volume\n" +
+		"1700920800000;10;12;9;11;100\n" +
+		"1701007200000;11;13;10;bad;200\n" +
+		"1701093600000;12;14;11;13\n" +
+		"1701180000000;13;15;12;14;300\n"
+
+	err := os.WriteFile(path.Join(base, "vendor.csv"), []byte(data), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := base + "?delimiter=%3B&comment=%23&date_format=unixmilli&timezone=America/New_York" +
+		"&header.Date=Timestamp&alias.Close=Close&alias.Close=Adj+Close&skip_malformed=true"
+
+	repository, err := asset.NewRepository(asset.FileSystemRepositoryBuilderName, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots, err := repository.Get("vendor")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := helper.ChanToSlice(snapshots)
+	if len(actual) != 2 {
+		t.Fatalf("actual %v expected 2 rows", len(actual))
+	}
+
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := time.Date(2023, 11, 25, 9, 0, 0, 0, location)
+	if !actual[0].Date.Equal(expected) || actual[0].Date.Location().String() != location.String() {
+		t.Fatalf("actual %v expected %v", actual[0].Date, expected)
+	}
+
+	if actual[0].Close != 11 || actual[1].Close != 14 {
+		t.Fatalf("actual %v %v expected 11 14", actual[0].Close, actual[1].Close)
+	}
+}
+
+func TestFileSystemRepositoryWithInvalidConfig(t *testing.T) {
+	configs := []string{
+		"testdata?unknown=1",
+		"testdata?delimiter=ab",
+		"testdata?timezone=Nowhere/Nothing",
+		"testdata?skip_malformed=maybe",
+		"testdata?%zz",
+	}
+
+	for _, config := range configs {
+		_, err := asset.NewFileSystemRepositoryWithConfig(config)
+		if err == nil {
+			t.Fatalf("expected error for %s", config)
+		}
+	}
+}
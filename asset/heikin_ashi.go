@@ -0,0 +1,47 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// SnapshotsAsHeikinAshiWithContext converts the snapshots in the provided channel
+// into Heikin-Ashi candles, and returns a new channel containing them as new
+// snapshots with the same dates and volumes, supporting context cancellation.
+// Heikin-Ashi candles average the prices to filter out the noise, so that any
+// indicator or strategy can run on the smoothed candles unchanged.
+//
+//	HA Close = (Open + High + Low + Close) / 4
+//	HA Open = (Previous HA Open + Previous HA Close) / 2
+//	HA High = Max(High, HA Open, HA Close)
+//	HA Low = Min(Low, HA Open, HA Close)
+//
+// The first HA Open is the average of the first open and close.
+func SnapshotsAsHeikinAshiWithContext(ctx context.Context, snapshots <-chan *Snapshot) <-chan *Snapshot {
+	var previous *Snapshot
+
+	return helper.MapWithContext(ctx, snapshots, func(snapshot *Snapshot) *Snapshot {
+		haClose := (snapshot.Open + snapshot.High + snapshot.Low + snapshot.Close) / 4
+
+		haOpen := (snapshot.Open + snapshot.Close) / 2
+		if previous != nil {
+			haOpen = (previous.Open + previous.Close) / 2
+		}
+
+		previous = &Snapshot{
+			Date:   snapshot.Date,
+			Open:   haOpen,
+			High:   max(snapshot.High, haOpen, haClose),
+			Low:    min(snapshot.Low, haOpen, haClose),
+			Close:  haClose,
+			Volume: snapshot.Volume,
+		}
+
+		return previous
+	})
+}
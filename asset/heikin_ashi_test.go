@@ -0,0 +1,35 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestSnapshotsAsHeikinAshi(t *testing.T) {
+	date := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	input := helper.SliceToChan([]*asset.Snapshot{
+		{Date: date, Open: 10, High: 14, Low: 8, Close: 12, Volume: 100},
+		{Date: date.AddDate(0, 0, 1), Open: 12, High: 16, Low: 12, Close: 16, Volume: 200},
+	})
+
+	expected := []*asset.Snapshot{
+		{Date: date, Open: 11, High: 14, Low: 8, Close: 11, Volume: 100},
+		{Date: date.AddDate(0, 0, 1), Open: 11, High: 16, Low: 11, Close: 14, Volume: 200},
+	}
+
+	actual := helper.ChanToSlice(asset.SnapshotsAsHeikinAshiWithContext(context.Background(), input))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
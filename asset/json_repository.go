@@ -0,0 +1,260 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// JSONRepository stores and retrieves asset snapshots using the local file
+// system, keeping each asset in a newline delimited JSON (NDJSON) file with
+// one snapshot per line.
+type JSONRepository struct {
+	// base is the root directory where asset snapshots are stored.
+	base string
+
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// NewJSONRepository initializes a JSON repository with the given base directory.
+func NewJSONRepository(base string) *JSONRepository {
+	return &JSONRepository{
+		base:   base,
+		Logger: slog.Default(),
+	}
+}
+
+// Assets returns the names of all assets in the repository.
+func (r *JSONRepository) Assets() ([]string, error) {
+	files, err := os.ReadDir(r.base)
+	if err != nil {
+		return nil, err
+	}
+
+	var assets []string
+
+	suffix := ".ndjson"
+
+	for _, file := range files {
+		name := file.Name()
+
+		if strings.HasSuffix(name, suffix) {
+			assets = append(assets, strings.TrimSuffix(name, suffix))
+		}
+	}
+
+	return assets, nil
+}
+
+// Get attempts to return a channel of snapshots for the asset with the given name.
+func (r *JSONRepository) Get(name string) (<-chan *Snapshot, error) {
+	file, err := os.Open(r.getFileName(name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrRepositoryAssetNotFound
+		}
+
+		return nil, err
+	}
+
+	wg := &sync.WaitGroup{}
+	snapshots := helper.Waitable(wg, helper.NDJSONToChanWithLoggerWithContext[*Snapshot](context.Background(), file, r.Logger))
+
+	go func() {
+		wg.Wait()
+		err := file.Close()
+		if err != nil {
+			r.Logger.Error("Unable to close file.", "error", err)
+		}
+	}()
+
+	return snapshots, nil
+}
+
+// GetSince attempts to return a channel of snapshots for the asset with the given name since the given date.
+func (r *JSONRepository) GetSince(name string, date time.Time) (<-chan *Snapshot, error) {
+	snapshots, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots = helper.FilterWithContext(context.Background(), snapshots, func(s *Snapshot) bool {
+		return s.Date.Equal(date) || s.Date.After(date)
+	})
+
+	return snapshots, nil
+}
+
+// LastDate returns the date of the last snapshot for the asset with the given name.
+func (r *JSONRepository) LastDate(name string) (time.Time, error) {
+	var last time.Time
+
+	snapshots, err := r.Get(name)
+	if err != nil {
+		return last, err
+	}
+
+	snapshot, ok := <-helper.LastWithContext(context.Background(), snapshots, 1)
+	if !ok {
+		return last, ErrRepositoryAssetEmpty
+	}
+
+	return snapshot.Date, nil
+}
+
+// Append adds the given snapshows to the asset with the given name.
+func (r *JSONRepository) Append(name string, snapshots <-chan *Snapshot) error {
+	file, err := os.OpenFile(r.getFileName(name), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	err = helper.ChanToNDJSONWithContext(context.Background(), snapshots, file)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// getFileName gets the NDJSON file name for the given asset name.
+func (r *JSONRepository) getFileName(name string) string {
+	return filepath.Join(r.base, fmt.Sprintf("%s.ndjson", name))
+}
+
+// AssetSnapshot is a snapshot along with the name of its asset. It is the
+// line format of the JSON stream repository, such as:
+//
+//	{"Asset":"SPY","Date":"2024-01-02T00:00:00Z","Open":472.16,"High":473.67,"Low":470.49,"Close":472.65,"Volume":123623700}
+type AssetSnapshot struct {
+	// Asset is the name of the asset.
+	Asset string
+
+	Snapshot
+}
+
+// JSONStreamRepository retrieves asset snapshots from a newline delimited
+// JSON (NDJSON) stream, and appends asset snapshots to another one, such as
+// the standard input and the standard output, so that the snapshots can be
+// piped in and out of other tools. Each line is an AssetSnapshot.
+//
+// The input stream can only be read once, so it is fully read into memory
+// on the first access.
+type JSONStreamRepository struct {
+	// reader is the input stream.
+	reader io.Reader
+
+	// writer is the output stream.
+	writer io.Writer
+
+	// readOnce guards reading the input stream.
+	readOnce sync.Once
+
+	// mu guards the storage and the writer.
+	mu sync.Mutex
+
+	// storage is the snapshots read from the input stream.
+	storage *InMemoryRepository
+
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// NewJSONStreamRepository initializes a JSON stream repository with the given
+// input and output streams.
+func NewJSONStreamRepository(reader io.Reader, writer io.Writer) *JSONStreamRepository {
+	return &JSONStreamRepository{
+		reader:  reader,
+		writer:  writer,
+		storage: NewInMemoryRepository(),
+		Logger:  slog.Default(),
+	}
+}
+
+// Assets returns the names of all assets in the input stream.
+func (r *JSONStreamRepository) Assets() ([]string, error) {
+	r.read()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.storage.Assets()
+}
+
+// Get attempts to return a channel of snapshots for the asset with the given name.
+func (r *JSONStreamRepository) Get(name string) (<-chan *Snapshot, error) {
+	r.read()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.storage.Get(name)
+}
+
+// GetSince attempts to return a channel of snapshots for the asset with the given name since the given date.
+func (r *JSONStreamRepository) GetSince(name string, date time.Time) (<-chan *Snapshot, error) {
+	r.read()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.storage.GetSince(name, date)
+}
+
+// LastDate returns the date of the last snapshot for the asset with the given name.
+func (r *JSONStreamRepository) LastDate(name string) (time.Time, error) {
+	r.read()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.storage.LastDate(name)
+}
+
+// Append writes the given snapshots of the asset with the given name to the output stream.
+func (r *JSONStreamRepository) Append(name string, snapshots <-chan *Snapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	assetSnapshots := helper.MapWithContext(context.Background(), snapshots, func(snapshot *Snapshot) *AssetSnapshot {
+		return &AssetSnapshot{
+			Asset:    name,
+			Snapshot: *snapshot,
+		}
+	})
+
+	return helper.ChanToNDJSONWithContext(context.Background(), assetSnapshots, r.writer)
+}
+
+// read reads the input stream into the storage once.
+func (r *JSONStreamRepository) read() {
+	r.readOnce.Do(func() {
+		if r.reader == nil {
+			return
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		for assetSnapshot := range helper.NDJSONToChanWithLoggerWithContext[*AssetSnapshot](context.Background(), r.reader, r.Logger) {
+			snapshot := assetSnapshot.Snapshot
+			r.storage.storage[assetSnapshot.Asset] = append(r.storage.storage[assetSnapshot.Asset], &snapshot)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestJSONRepository(t *testing.T) {
+	repository := asset.NewJSONRepository(t.TempDir())
+
+	expected, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/since.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots := helper.ChanToSlice(expected)
+
+	err = repository.Append("brk-b", helper.SliceToChan(snapshots[:2]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = repository.Append("brk-b", helper.SliceToChan(snapshots[2:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assets, err := repository.Assets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(assets, []string{"brk-b"}) {
+		t.Fatalf("actual %v expected [brk-b]", assets)
+	}
+
+	actual, err := repository.Get("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(actual, helper.SliceToChan(snapshots))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	since, err := repository.GetSince("brk-b", snapshots[1].Date)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(since, helper.SliceToChan(snapshots[1:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lastDate, err := repository.LastDate("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !lastDate.Equal(snapshots[len(snapshots)-1].Date) {
+		t.Fatalf("actual %v expected %v", lastDate, snapshots[len(snapshots)-1].Date)
+	}
+}
+
+func TestJSONRepositoryNotFound(t *testing.T) {
+	repository := asset.NewJSONRepository(t.TempDir())
+
+	_, err := repository.Get("brk-b")
+	if !errors.Is(err, asset.ErrRepositoryAssetNotFound) {
+		t.Fatalf("actual %v expected %v", err, asset.ErrRepositoryAssetNotFound)
+	}
+
+	_, err = repository.LastDate("brk-b")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	_, err = asset.NewJSONRepository("testdata/non_existing").Assets()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestJSONStreamRepository(t *testing.T) {
+	input := strings.NewReader(
+		`{"Asset":"A","Date":"2024-01-02T00:00:00Z","Open":1,"High":2,"Low":0.5,"Close":1.5,"Volume":100}` + "\n" +
+			`{"Asset":"B","Date":"2024-01-02T00:00:00Z","Open":10,"High":20,"Low":5,"Close":15,"Volume":1000}` + "\n" +
+			`{"Asset":"A","Date":"2024-01-03T00:00:00Z","Open":1.5,"High":3,"Low":1,"Close":2.5,"Volume":200}` + "\n",
+	)
+
+	var output bytes.Buffer
+
+	repository := asset.NewJSONStreamRepository(input, &output)
+
+	assets, err := repository.Assets()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(assets)
+
+	if !reflect.DeepEqual(assets, []string{"A", "B"}) {
+		t.Fatalf("actual %v expected [A B]", assets)
+	}
+
+	lastDate, err := repository.LastDate("A")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !lastDate.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("actual %v", lastDate)
+	}
+
+	since, err := repository.GetSince("A", lastDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots := helper.ChanToSlice(since)
+	if len(snapshots) != 1 || snapshots[0].Close != 2.5 {
+		t.Fatalf("actual %v", snapshots)
+	}
+
+	b, err := repository.Get("B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = repository.Append("C", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"Asset":"C","Date":"2024-01-02T00:00:00Z","Open":10,"High":20,"Low":5,"Close":15,"Volume":1000}` + "\n"
+	if output.String() != expected {
+		t.Fatalf("actual %s expected %s", output.String(), expected)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "context"
+
+// kagiBuilder builds the Kagi lines.
+type kagiBuilder struct {
+	reversal   float64
+	percentage float64
+	started    bool
+	direction  int
+	start      float64
+	end        float64
+	volume     float64
+}
+
+// NewKagiBuilder returns a new bar builder for the lines of a Kagi chart with
+// the given reversal amount.
+//
+// The line follows the closings in its direction, and turns when the closing
+// moves against it by at least the reversal amount. Each line is completed by
+// a turn, with its start as the opening, and its end as the closing. A line
+// that ends above the high of the previous line in the same direction is a
+// yang (thick) line, and one that ends below its low is a yin (thin) line.
+func NewKagiBuilder(reversal float64) BarBuilder {
+	return &kagiBuilder{
+		reversal: reversal,
+	}
+}
+
+// NewPercentKagiBuilder returns a new bar builder for the lines of a Kagi
+// chart like NewKagiBuilder, where the reversal amount is the given percentage
+// of the end of the line, such as 0.04 for 4%.
+func NewPercentKagiBuilder(percentage float64) BarBuilder {
+	return &kagiBuilder{
+		percentage: percentage,
+	}
+}
+
+// Add adds the given snapshot, and returns the line that it completed, if any.
+func (k *kagiBuilder) Add(snapshot *Snapshot) []*Snapshot {
+	closing := snapshot.Close
+
+	if !k.started {
+		k.started = true
+		k.start, k.end = closing, closing
+		k.volume = snapshot.Volume
+		return nil
+	}
+
+	amount := k.reversal
+	if k.percentage != 0 {
+		amount = k.end * k.percentage
+	}
+
+	switch {
+	case k.direction == 0:
+		if closing >= k.start+amount {
+			k.direction = 1
+			k.end = closing
+		} else if closing <= k.start-amount {
+			k.direction = -1
+			k.end = closing
+		}
+
+	case (k.direction > 0 && closing > k.end) || (k.direction < 0 && closing < k.end):
+		k.end = closing
+
+	case (k.direction > 0 && closing <= k.end-amount) || (k.direction < 0 && closing >= k.end+amount):
+		line := newBar(snapshot, k.start, k.end, k.volume)
+		k.start, k.end, k.volume = k.end, closing, snapshot.Volume
+		k.direction = -k.direction
+
+		return []*Snapshot{line}
+	}
+
+	k.volume += snapshot.Volume
+
+	return nil
+}
+
+// SnapshotsAsKagiWithContext converts the snapshots in the provided channel
+// into the lines of a Kagi chart with the given reversal amount, and returns a
+// new channel containing them, supporting context cancellation.
+//
+// Example:
+//
+//	lines := asset.SnapshotsAsKagiWithContext(ctx, snapshots, 5)
+func SnapshotsAsKagiWithContext(ctx context.Context, snapshots <-chan *Snapshot, reversal float64) <-chan *Snapshot {
+	return SnapshotsAsBarsWithContext(ctx, snapshots, NewKagiBuilder(reversal))
+}
+
+// SnapshotsAsPercentKagiWithContext converts the snapshots in the provided
+// channel into the lines of a Kagi chart with the given percentage reversal
+// amount, and returns a new channel containing them, supporting context
+// cancellation.
+func SnapshotsAsPercentKagiWithContext(ctx context.Context, snapshots <-chan *Snapshot, percentage float64) <-chan *Snapshot {
+	return SnapshotsAsBarsWithContext(ctx, snapshots, NewPercentKagiBuilder(percentage))
+}
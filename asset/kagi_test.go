@@ -0,0 +1,47 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+func TestSnapshotsAsKagi(t *testing.T) {
+	ctx := context.Background()
+
+	input := closingSnapshots(100, 103, 108, 106, 102, 99, 101, 105)
+
+	expected := []barSummary{
+		{Day: 5, Open: 100, Close: 108},
+		{Day: 8, Open: 108, Close: 99},
+	}
+
+	actual := summarizeBars(asset.SnapshotsAsKagiWithContext(ctx, input, 5))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestSnapshotsAsPercentKagi(t *testing.T) {
+	ctx := context.Background()
+
+	input := closingSnapshots(100, 110, 104, 120)
+
+	expected := []barSummary{
+		{Day: 3, Open: 100, Close: 110},
+		{Day: 4, Open: 110, Close: 104},
+	}
+
+	actual := summarizeBars(asset.SnapshotsAsPercentKagiWithContext(ctx, input, 0.05))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
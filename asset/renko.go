@@ -0,0 +1,105 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// renkoBuilder builds the Renko bricks.
+type renkoBuilder struct {
+	boxSize float64
+	started bool
+	low     float64
+	high    float64
+
+	// Average True Range when the box size is the ATR.
+	atr *helper.AverageTrueRange[float64]
+}
+
+// NewRenkoBuilder returns a new bar builder for the Renko bricks of the given
+// box size.
+//
+// A new brick is added in the direction of the last brick each time the
+// closing moves one more box beyond it, and in the opposite direction when the
+// closing moves one box beyond its opening, which is a two boxes reversal. The
+// volume of the snapshot is given to the first brick that it completes.
+func NewRenkoBuilder(boxSize float64) BarBuilder {
+	return &renkoBuilder{
+		boxSize: boxSize,
+	}
+}
+
+// NewAtrRenkoBuilder returns a new bar builder for the Renko bricks like
+// NewRenkoBuilder, where the box size is the Average True Range (ATR) of the
+// given period at each snapshot, so that the bricks adapt to the volatility.
+// The ATR is the simple moving average of the true ranges, and the snapshots
+// before it is available complete no bricks.
+func NewAtrRenkoBuilder(period int) BarBuilder {
+	return &renkoBuilder{
+		atr: helper.NewAverageTrueRange[float64](period),
+	}
+}
+
+// Add adds the given snapshot, and returns the bricks that it completed.
+func (r *renkoBuilder) Add(snapshot *Snapshot) []*Snapshot {
+	box := r.boxSize
+
+	if r.atr != nil {
+		var ok bool
+
+		box, ok = r.atr.Add(snapshot.High, snapshot.Low, snapshot.Close)
+		if !ok {
+			return nil
+		}
+	}
+
+	if !r.started {
+		r.started = true
+		r.low, r.high = snapshot.Close, snapshot.Close
+		return nil
+	}
+
+	if box <= 0 {
+		return nil
+	}
+
+	var bricks []*Snapshot
+	volume := snapshot.Volume
+
+	for {
+		if snapshot.Close >= r.high+box {
+			r.low, r.high = r.high, r.high+box
+			bricks = append(bricks, newBar(snapshot, r.low, r.high, volume))
+		} else if snapshot.Close <= r.low-box {
+			r.low, r.high = r.low-box, r.low
+			bricks = append(bricks, newBar(snapshot, r.high, r.low, volume))
+		} else {
+			return bricks
+		}
+
+		volume = 0
+	}
+}
+
+// SnapshotsAsRenkoWithContext converts the snapshots in the provided channel
+// into the Renko bricks of the given box size, and returns a new channel
+// containing them, supporting context cancellation.
+//
+// Example:
+//
+//	bricks := asset.SnapshotsAsRenkoWithContext(ctx, snapshots, 5)
+func SnapshotsAsRenkoWithContext(ctx context.Context, snapshots <-chan *Snapshot, boxSize float64) <-chan *Snapshot {
+	return SnapshotsAsBarsWithContext(ctx, snapshots, NewRenkoBuilder(boxSize))
+}
+
+// SnapshotsAsAtrRenkoWithContext converts the snapshots in the provided channel
+// into the Renko bricks with the ATR of the given period as the box size, and
+// returns a new channel containing them, supporting context cancellation.
+func SnapshotsAsAtrRenkoWithContext(ctx context.Context, snapshots <-chan *Snapshot, period int) <-chan *Snapshot {
+	return SnapshotsAsBarsWithContext(ctx, snapshots, NewAtrRenkoBuilder(period))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestSnapshotsAsRenko(t *testing.T) {
+	ctx := context.Background()
+
+	input := closingSnapshots(100, 104, 111, 109, 103, 99, 94)
+
+	expected := []barSummary{
+		{Day: 3, Open: 100, Close: 105},
+		{Day: 3, Open: 105, Close: 110},
+		{Day: 6, Open: 105, Close: 100},
+		{Day: 7, Open: 100, Close: 95},
+	}
+
+	actual := summarizeBars(asset.SnapshotsAsRenkoWithContext(ctx, input, 5))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestSnapshotsAsAtrRenko(t *testing.T) {
+	ctx := context.Background()
+
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bricks := helper.ChanToSlice(asset.SnapshotsAsAtrRenkoWithContext(ctx, snapshots, 14))
+	if len(bricks) == 0 {
+		t.Fatal("no bricks")
+	}
+
+	for i := 1; i < len(bricks); i++ {
+		if bricks[i].Date.Before(bricks[i-1].Date) {
+			t.Fatalf("brick %d date %v before %v", i, bricks[i].Date, bricks[i-1].Date)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package asset
 
 import (
 	"fmt"
+	"os"
 )
 
 const (
@@ -17,6 +18,13 @@ const (
 
 	// TiingoRepositoryBuilderName is the name of the Tiingo repository builder.
 	TiingoRepositoryBuilderName = "tiingo"
+
+	// JSONRepositoryBuilderName is the name of the JSON repository builder.
+	JSONRepositoryBuilderName = "json"
+
+	// JSONRepositoryStandardStreams is the JSON repository configuration for using
+	// the standard input and the standard output as the NDJSON streams.
+	JSONRepositoryStandardStreams = "-"
 )
 
 // RepositoryBuilderFunc defines a function to build a new repository using the given configuration parameter.
@@ -27,6 +35,7 @@ var repositoryBuilders = map[string]RepositoryBuilderFunc{
 	InMemoryRepositoryBuilderName:   inMemoryRepositoryBuilder,
 	FileSystemRepositoryBuilderName: fileSystemRepositoryBuilder,
 	TiingoRepositoryBuilderName:     tiingoRepositoryBuilder,
+	JSONRepositoryBuilderName:       jsonRepositoryBuilder,
 }
 
 // RegisterRepositoryBuilder registers the given builder.
@@ -51,10 +60,20 @@ func inMemoryRepositoryBuilder(_ string) (Repository, error) {
 
 // fileSystemRepositoryBuilder builds a new file system repository instance.
 func fileSystemRepositoryBuilder(config string) (Repository, error) {
-	return NewFileSystemRepository(config), nil
+	return NewFileSystemRepositoryWithConfig(config)
 }
 
 // tiingoRepositoryBuilder builds a new Tiingo repository instance.
 func tiingoRepositoryBuilder(config string) (Repository, error) {
 	return NewTiingoRepository(config), nil
 }
+
+// jsonRepositoryBuilder builds a new JSON repository instance. The configuration is either
+// the base directory, or "-" for the standard input and the standard output streams.
+func jsonRepositoryBuilder(config string) (Repository, error) {
+	if config == JSONRepositoryStandardStreams {
+		return NewJSONStreamRepository(os.Stdin, os.Stdout), nil
+	}
+
+	return NewJSONRepository(config), nil
+}
@@ -75,3 +75,25 @@ func TestNewTiingoRepository(t *testing.T) {
 		t.Fatalf("repository not correct type: %T", repository)
 	}
 }
+
+func TestNewJSONRepository(t *testing.T) {
+	repository, err := asset.NewRepository(asset.JSONRepositoryBuilderName, "testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := repository.(*asset.JSONRepository)
+	if !ok {
+		t.Fatalf("repository not correct type: %T", repository)
+	}
+
+	repository, err = asset.NewRepository(asset.JSONRepositoryBuilderName, asset.JSONRepositoryStandardStreams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok = repository.(*asset.JSONStreamRepository)
+	if !ok {
+		t.Fatalf("repository not correct type: %T", repository)
+	}
+}
@@ -133,3 +133,26 @@ func SnapshotsAsVolumesWithContext(ctx context.Context, snapshots <-chan *Snapsh
 func SnapshotsAsVolumes(snapshots <-chan *Snapshot) <-chan float64 {
 	return SnapshotsAsVolumesWithContext(context.Background(), snapshots)
 }
+
+// SnapshotsAsDatedClosingsWithContext extracts the date and close fields from each snapshot in the provided
+// channel and returns a new channel containing those dated close values, supporting context cancellation.
+func SnapshotsAsDatedClosingsWithContext(ctx context.Context, snapshots <-chan *Snapshot) <-chan helper.Dated[float64] {
+	return helper.MapWithContext(ctx, snapshots, func(snapshot *Snapshot) helper.Dated[float64] {
+		return helper.NewDated(snapshot.Date, snapshot.Close)
+	})
+}
+
+// SnapshotsAsCandlestickReportColumnWithContext returns a candlestick report column with the
+// opening, high, low, and closing values of each snapshot in the provided channel, supporting
+// context cancellation.
+func SnapshotsAsCandlestickReportColumnWithContext(ctx context.Context, name string, snapshots <-chan *Snapshot) helper.ReportColumn {
+	splice := helper.DuplicateWithContext(ctx, snapshots, 4)
+
+	return helper.NewCandlestickReportColumn(
+		name,
+		SnapshotsAsOpeningsWithContext(ctx, splice[0]),
+		SnapshotsAsHighsWithContext(ctx, splice[1]),
+		SnapshotsAsLowsWithContext(ctx, splice[2]),
+		SnapshotsAsClosingsWithContext(ctx, splice[3]),
+	)
+}
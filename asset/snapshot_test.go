@@ -5,6 +5,7 @@
 package asset_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cinar/indicator/v2/asset"
@@ -59,3 +60,36 @@ func TestSnapshotsAs(t *testing.T) {
 		}
 	}
 }
+
+func TestSnapshotsAsDatedClosings(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsCopies := helper.Duplicate(snapshots, 2)
+	datedClosings := asset.SnapshotsAsDatedClosingsWithContext(context.Background(), snapshotsCopies[1])
+
+	for snapshot := range snapshotsCopies[0] {
+		datedClosing := <-datedClosings
+
+		if !datedClosing.Date.Equal(snapshot.Date) || datedClosing.Value != snapshot.Close {
+			t.Fatalf("actual %v expected %v %v", datedClosing, snapshot.Date, snapshot.Close)
+		}
+	}
+}
+
+func TestSnapshotsAsCandlestickReportColumn(t *testing.T) {
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Open: 10, High: 12, Low: 9, Close: 11},
+	})
+
+	column := asset.SnapshotsAsCandlestickReportColumnWithContext(context.Background(), "Price", snapshots)
+
+	expected := "9, 10, 11, 12"
+	actual := column.Value()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
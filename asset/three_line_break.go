@@ -0,0 +1,100 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "context"
+
+const (
+	// DefaultLineBreakCount is the default number of lines that a reversal must break.
+	DefaultLineBreakCount = 3
+)
+
+// lineBreakBuilder builds the Line Break lines.
+type lineBreakBuilder struct {
+	count   int
+	started bool
+	first   float64
+	volume  float64
+	lines   []*Snapshot
+}
+
+// NewLineBreakBuilder returns a new bar builder for the lines of a Line Break
+// chart, where a reversal must break the range of the given number of
+// previous lines, such as the three for the Three-Line-Break chart.
+//
+// A new line is added in the direction of the last line when the closing goes
+// beyond its closing, and in the opposite direction when the closing goes
+// beyond the extreme of the last count lines, starting from the opening of the
+// last line.
+func NewLineBreakBuilder(count int) BarBuilder {
+	return &lineBreakBuilder{
+		count: count,
+	}
+}
+
+// Add adds the given snapshot, and returns the line that it added, if any.
+func (l *lineBreakBuilder) Add(snapshot *Snapshot) []*Snapshot {
+	closing := snapshot.Close
+	l.volume += snapshot.Volume
+
+	if !l.started {
+		l.started = true
+		l.first = closing
+		return nil
+	}
+
+	var opening float64
+
+	if len(l.lines) == 0 {
+		if closing == l.first {
+			return nil
+		}
+
+		opening = l.first
+	} else {
+		last := l.lines[len(l.lines)-1]
+
+		lowest, highest := last.Low, last.High
+		for _, line := range l.lines {
+			lowest = min(lowest, line.Low)
+			highest = max(highest, line.High)
+		}
+
+		isUp := last.Close > last.Open
+
+		switch {
+		case (isUp && closing > last.Close) || (!isUp && closing < last.Close):
+			opening = last.Close
+
+		case (isUp && closing < lowest) || (!isUp && closing > highest):
+			opening = last.Open
+
+		default:
+			return nil
+		}
+	}
+
+	line := newBar(snapshot, opening, closing, l.volume)
+	l.volume = 0
+
+	// Only the last count lines are needed for the reversals.
+	l.lines = append(l.lines, line)
+	if len(l.lines) > l.count {
+		l.lines = l.lines[1:]
+	}
+
+	return []*Snapshot{line}
+}
+
+// SnapshotsAsThreeLineBreakWithContext converts the snapshots in the provided
+// channel into the lines of a Three-Line-Break chart, and returns a new channel
+// containing them, supporting context cancellation.
+//
+// Example:
+//
+//	lines := asset.SnapshotsAsThreeLineBreakWithContext(ctx, snapshots)
+func SnapshotsAsThreeLineBreakWithContext(ctx context.Context, snapshots <-chan *Snapshot) <-chan *Snapshot {
+	return SnapshotsAsBarsWithContext(ctx, snapshots, NewLineBreakBuilder(DefaultLineBreakCount))
+}
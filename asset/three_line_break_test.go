@@ -0,0 +1,33 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+func TestSnapshotsAsThreeLineBreak(t *testing.T) {
+	ctx := context.Background()
+
+	input := closingSnapshots(100, 102, 101, 104, 107, 103, 100, 99, 98)
+
+	expected := []barSummary{
+		{Day: 2, Open: 100, Close: 102},
+		{Day: 4, Open: 102, Close: 104},
+		{Day: 5, Open: 104, Close: 107},
+		{Day: 8, Open: 104, Close: 99},
+		{Day: 9, Open: 99, Close: 98},
+	}
+
+	actual := summarizeBars(asset.SnapshotsAsThreeLineBreakWithContext(ctx, input))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
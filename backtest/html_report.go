@@ -51,6 +51,33 @@ type HTMLReport struct {
 	// DateFormat is the date format that is used in the reports.
 	DateFormat string
 
+	// Offline indicates whether the reports should be self-contained, with the
+	// stylesheet embedded and the charts rendered as inline SVG images, so
+	// that they open without network access.
+	Offline bool
+
+	// Theme is the theme of the reports, such as helper.ReportThemeDark.
+	Theme string
+
+	// CSS is the custom style rules added to the reports.
+	CSS string
+
+	// Template is the custom template for the main report. It is parsed after
+	// the default template, so it can either replace it, or only redefine the
+	// "head", "top", and "bottom" blocks.
+	Template string
+
+	// AssetTemplate is the custom template for the asset reports, similar to
+	// the Template.
+	AssetTemplate string
+
+	// StrategyTemplate is the custom template for the individual strategy
+	// reports, similar to the helper.Report Template.
+	StrategyTemplate string
+
+	// Funcs is the additional functions made available to the templates.
+	Funcs template.FuncMap
+
 	// Logger is the slog logger instance.
 	Logger *slog.Logger
 }
@@ -83,6 +110,7 @@ func NewHTMLReport(outputDir string) *HTMLReport {
 		assetResults:         make(map[string][]*htmlReportResult),
 		WriteStrategyReports: DefaultWriteStrategyReports,
 		DateFormat:           helper.DefaultReportDateFormat,
+		Theme:                helper.ReportThemeLight,
 		Logger:               slog.Default(),
 	}
 }
@@ -125,6 +153,11 @@ func (h *HTMLReport) Write(assetName string, currentStrategy strategy.Strategy,
 	if h.WriteStrategyReports {
 		report := currentStrategy.Report(snapshots)
 		report.DateFormat = h.DateFormat
+		report.Offline = h.Offline
+		report.Theme = h.Theme
+		report.CSS = h.CSS
+		report.Template = h.StrategyTemplate
+		report.Funcs = h.Funcs
 
 		reportFile := h.strategyReportFileName(assetName, currentStrategy.Name())
 
@@ -205,12 +238,23 @@ func (h *HTMLReport) writeAssetReport(name string, results []*htmlReportResult)
 		AssetName   string
 		Results     []*htmlReportResult
 		GeneratedOn string
+		Offline     bool
+		Theme       string
+		Style       string
 	}
 
 	model := Model{
 		AssetName:   name,
 		Results:     results,
 		GeneratedOn: time.Now().String(),
+		Offline:     h.Offline,
+		Theme:       h.Theme,
+		Style:       helper.ReportStyle(h.Offline, h.Theme, h.CSS),
+	}
+
+	tmpl, err := helper.ParseReportTemplate(htmlAssetReportTmpl, h.AssetTemplate, h.Funcs)
+	if err != nil {
+		return fmt.Errorf("unable to parse asset report template: %w", err)
 	}
 
 	file, err := os.Create(filepath.Join(h.outputDir, fmt.Sprintf("%s.html", name)))
@@ -220,8 +264,6 @@ func (h *HTMLReport) writeAssetReport(name string, results []*htmlReportResult)
 
 	defer helper.CloseAndLogError(file, "unable to close asset report file")
 
-	tmpl := template.Must(template.New("report").Parse(htmlAssetReportTmpl))
-
 	err = tmpl.Execute(file, model)
 	if err != nil {
 		return fmt.Errorf("unable to execute asset report template: %w", err)
@@ -235,11 +277,22 @@ func (h *HTMLReport) writeReport() error {
 	type Model struct {
 		Results     []*htmlReportResult
 		GeneratedOn string
+		Offline     bool
+		Theme       string
+		Style       string
 	}
 
 	model := Model{
 		Results:     h.bestResults,
 		GeneratedOn: time.Now().String(),
+		Offline:     h.Offline,
+		Theme:       h.Theme,
+		Style:       helper.ReportStyle(h.Offline, h.Theme, h.CSS),
+	}
+
+	tmpl, err := helper.ParseReportTemplate(htmlReportTmpl, h.Template, h.Funcs)
+	if err != nil {
+		return fmt.Errorf("unable to parse main report template: %w", err)
 	}
 
 	file, err := os.Create(filepath.Join(h.outputDir, "index.html"))
@@ -249,8 +302,6 @@ func (h *HTMLReport) writeReport() error {
 
 	defer helper.CloseAndLogError(file, "unable to close main report file")
 
-	tmpl := template.Must(template.New("report").Parse(htmlReportTmpl))
-
 	err = tmpl.Execute(file, model)
 	if err != nil {
 		return fmt.Errorf("unable to execute main report template: %w", err)
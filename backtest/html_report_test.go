@@ -7,7 +7,9 @@ package backtest_test
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"text/template"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/backtest"
@@ -178,3 +180,120 @@ func TestHTMLReportNoStrategyReports(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestHTMLReportOffline(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "report_offline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer helper.RemoveAll(t, outputDir)
+
+	report := backtest.NewHTMLReport(outputDir)
+	report.Offline = true
+
+	bt := backtest.NewBacktest(repository, report)
+	bt.LastDays = 100 * 365
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, strategy.NewBuyAndHoldStrategy())
+
+	err = bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fileName := range []string{"index.html", "brk-b.html", "brk-b - Buy and Hold Strategy.html"} {
+		content, err := os.ReadFile(filepath.Join(outputDir, fileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, external := range []string{"<script", "<link", "gstatic.com", "jsdelivr.net"} {
+			if strings.Contains(string(content), external) {
+				t.Fatalf("%s contains %q", fileName, external)
+			}
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "brk-b - Buy and Hold Strategy.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), "<path") {
+		t.Fatal("strategy report chart not found")
+	}
+}
+
+func TestHTMLReportCustomTemplates(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "report_custom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer helper.RemoveAll(t, outputDir)
+
+	report := backtest.NewHTMLReport(outputDir)
+	report.Theme = helper.ReportThemeDark
+	report.CSS = ".custom-css { color: red; }"
+	report.Template = `{{ define "top" }}<p class="notes">{{ shout "notes" }}</p>{{ end }}`
+	report.AssetTemplate = `{{ define "bottom" }}<p class="asset-notes">{{ .AssetName }}</p>{{ end }}`
+	report.StrategyTemplate = `{{ define "top" }}<p class="strategy-notes">{{ shout .Title }}</p>{{ end }}`
+	report.Funcs = template.FuncMap{
+		"shout": strings.ToUpper,
+	}
+
+	bt := backtest.NewBacktest(repository, report)
+	bt.LastDays = 100 * 365
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, strategy.NewBuyAndHoldStrategy())
+
+	err = bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		"index.html":                         {`<p class="notes">NOTES</p>`, ".custom-css", "#14161a"},
+		"brk-b.html":                         {`<p class="asset-notes">brk-b</p>`, ".custom-css", "#14161a"},
+		"brk-b - Buy and Hold Strategy.html": {`<p class="strategy-notes">BUY AND HOLD STRATEGY</p>`, ".custom-css", "#14161a"},
+	}
+
+	for fileName, contents := range expected {
+		content, err := os.ReadFile(filepath.Join(outputDir, fileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, e := range contents {
+			if !strings.Contains(string(content), e) {
+				t.Fatalf("%s does not contain %s", fileName, e)
+			}
+		}
+	}
+}
+
+func TestHTMLReportInvalidTemplate(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "report_invalid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer helper.RemoveAll(t, outputDir)
+
+	report := backtest.NewHTMLReport(outputDir)
+	report.WriteStrategyReports = false
+	report.Template = "{{ unknown }}"
+
+	bt := backtest.NewBacktest(repository, report)
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, strategy.NewBuyAndHoldStrategy())
+
+	err = bt.Run()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// NDJSONReportRecord is a single line of the NDJSON report, holding the
+// action recommended by a strategy for an asset on a given date.
+type NDJSONReportRecord struct {
+	// Asset is the asset name.
+	Asset string
+
+	// Strategy is the strategy name.
+	Strategy string
+
+	// Date is the date of the snapshot.
+	Date time.Time
+
+	// Close is the closing price of the snapshot.
+	Close float64
+
+	// Action is the action recommended by the strategy.
+	Action strategy.Action
+
+	// Outcome is the strategy outcome up to the date.
+	Outcome float64
+}
+
+// NDJSONReport is the backtest report writing the strategy actions and
+// outcomes for every snapshot as newline delimited JSON (NDJSON), with one
+// NDJSONReportRecord per line, so that they can be piped to other tools.
+type NDJSONReport struct {
+	// writer is the output stream.
+	writer io.Writer
+
+	// closer closes the output stream when the backtest ends, if it is owned by the report.
+	closer io.Closer
+}
+
+// NewNDJSONReport initializes a new NDJSON report instance writing to the given writer.
+func NewNDJSONReport(writer io.Writer) *NDJSONReport {
+	return &NDJSONReport{
+		writer: writer,
+	}
+}
+
+// NewNDJSONReportFile initializes a new NDJSON report instance writing to the file with the
+// given name. The file is closed when the backtest ends.
+func NewNDJSONReportFile(fileName string) (*NDJSONReport, error) {
+	file, err := os.Create(filepath.Clean(fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	report := NewNDJSONReport(file)
+	report.closer = file
+
+	return report, nil
+}
+
+// Begin is called when the backtest begins.
+func (*NDJSONReport) Begin(_ []string, _ []strategy.Strategy) error {
+	return nil
+}
+
+// AssetBegin is called when backtesting for the given asset begins.
+func (*NDJSONReport) AssetBegin(_ string, _ []strategy.Strategy) error {
+	return nil
+}
+
+// Write writes the given strategy actions and outomes to the report.
+func (n *NDJSONReport) Write(assetName string, currentStrategy strategy.Strategy, snapshots <-chan *asset.Snapshot, actions <-chan strategy.Action, outcomes <-chan float64) error {
+	strategyName := currentStrategy.Name()
+
+	records := helper.Operate3(snapshots, actions, outcomes, func(snapshot *asset.Snapshot, action strategy.Action, outcome float64) *NDJSONReportRecord {
+		return &NDJSONReportRecord{
+			Asset:    assetName,
+			Strategy: strategyName,
+			Date:     snapshot.Date,
+			Close:    snapshot.Close,
+			Action:   action,
+			Outcome:  outcome,
+		}
+	})
+
+	err := helper.ChanToNDJSONWithContext(context.Background(), records, n.writer)
+	if err != nil {
+		go helper.Drain(records)
+	}
+
+	return err
+}
+
+// AssetEnd is called when backtesting for the given asset ends.
+func (*NDJSONReport) AssetEnd(_ string) error {
+	return nil
+}
+
+// End is called when the backtest ends.
+func (n *NDJSONReport) End() error {
+	if n.closer != nil {
+		return n.closer.Close()
+	}
+
+	return nil
+}
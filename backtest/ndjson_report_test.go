@@ -0,0 +1,114 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/backtest"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// ndjsonReportLastDays covers all snapshots in the test repository.
+const ndjsonReportLastDays = 100 * 365
+
+// countSnapshots returns the number of snapshots of the given asset.
+func countSnapshots(t *testing.T, repository asset.Repository, name string) int {
+	t.Helper()
+
+	snapshots, err := repository.Get(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return len(helper.ChanToSlice(snapshots))
+}
+
+func TestNDJSONReport(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	var buffer bytes.Buffer
+
+	bt := backtest.NewBacktest(repository, backtest.NewNDJSONReport(&buffer))
+	bt.LastDays = ndjsonReportLastDays
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, strategy.NewBuyAndHoldStrategy())
+
+	err := bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := helper.ChanToSlice(helper.NDJSONToChanWithContext[*backtest.NDJSONReportRecord](context.Background(), &buffer))
+	expected := countSnapshots(t, repository, "brk-b")
+	if len(records) != expected {
+		t.Fatalf("actual %v expected %v", len(records), expected)
+	}
+
+	first := records[0]
+	if first.Asset != "brk-b" || first.Strategy != strategy.NewBuyAndHoldStrategy().Name() || first.Action != strategy.Buy {
+		t.Fatalf("actual %v", first)
+	}
+
+	for i := 1; i < len(records); i++ {
+		if !records[i].Date.After(records[i-1].Date) {
+			t.Fatalf("records not in date order at %d", i)
+		}
+	}
+}
+
+func TestNDJSONReportFile(t *testing.T) {
+	fileName := path.Join(t.TempDir(), "report.ndjson")
+
+	report, err := backtest.NewReport(backtest.NDJSONReportBuilderName, fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	bt := backtest.NewBacktest(repository, report)
+	bt.LastDays = ndjsonReportLastDays
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, strategy.NewBuyAndHoldStrategy())
+
+	err = bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := countSnapshots(t, repository, "brk-b")
+	if bytes.Count(data, []byte{'\n'}) != expected {
+		t.Fatalf("actual %v expected %v", bytes.Count(data, []byte{'\n'}), expected)
+	}
+}
+
+func TestNDJSONReportStandardOutput(t *testing.T) {
+	report, err := backtest.NewReport(backtest.NDJSONReportBuilderName, "-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := report.(*backtest.NDJSONReport)
+	if !ok {
+		t.Fatalf("report not correct type: %T", report)
+	}
+
+	_, err = backtest.NewReport(backtest.NDJSONReportBuilderName, path.Join(t.TempDir(), "missing", "report.ndjson"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
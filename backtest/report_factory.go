@@ -6,12 +6,19 @@ package backtest
 
 import (
 	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
 )
 
 const (
 	// HTMLReportBuilderName is the name for the HTML report builder.
 	HTMLReportBuilderName = "html"
+
+	// NDJSONReportBuilderName is the name for the NDJSON report builder.
+	NDJSONReportBuilderName = "ndjson"
 )
 
 // ReportBuilderFunc defines a function to build a new report using the given configuration parameter.
@@ -22,7 +29,8 @@ var reportBuildersMu sync.RWMutex
 
 // reportBuilders provides mapping for the report builders.
 var reportBuilders = map[string]ReportBuilderFunc{
-	HTMLReportBuilderName: htmlReportBuilder,
+	HTMLReportBuilderName:   htmlReportBuilder,
+	NDJSONReportBuilderName: ndjsonReportBuilder,
 }
 
 // RegisterReportBuilder registers the given builder.
@@ -46,7 +54,45 @@ func NewReport(name, config string) (Report, error) {
 	return builder(config)
 }
 
-// htmlReportBuilder builds a new HTML report instance.
+// htmlReportBuilder builds a new HTML report instance. The configuration is the output
+// directory, optionally followed by the query parameters, such as "reports?offline=true&theme=dark".
 func htmlReportBuilder(config string) (Report, error) {
-	return NewHTMLReport(config), nil
+	outputDir, query, _ := strings.Cut(config, "?")
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	report := NewHTMLReport(outputDir)
+
+	for key, list := range values {
+		value := list[len(list)-1]
+
+		switch key {
+		case "offline":
+			report.Offline, err = strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+
+		case "theme":
+			report.Theme = value
+
+		default:
+			return nil, fmt.Errorf("unknown HTML report option: %s", key)
+		}
+	}
+
+	return report, nil
+}
+
+// ndjsonReportBuilder builds a new NDJSON report instance. The configuration is the output
+// file name, or "-" for the standard output.
+func ndjsonReportBuilder(config string) (Report, error) {
+	if config == "-" {
+		return NewNDJSONReport(os.Stdout), nil
+	}
+
+	return NewNDJSONReportFile(config)
 }
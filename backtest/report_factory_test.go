@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/cinar/indicator/v2/backtest"
+	"github.com/cinar/indicator/v2/helper"
 )
 
 func TestNewReportUnknown(t *testing.T) {
@@ -51,3 +52,32 @@ func TestNewReportMemory(t *testing.T) {
 		t.Fatalf("report not correct type: %T", report)
 	}
 }
+
+func TestNewReportHTMLOffline(t *testing.T) {
+	report, err := backtest.NewReport(backtest.HTMLReportBuilderName, "reports?offline=true&theme=dark")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	htmlReport, ok := report.(*backtest.HTMLReport)
+	if !ok {
+		t.Fatalf("report not correct type: %T", report)
+	}
+
+	if !htmlReport.Offline {
+		t.Fatal("report is not offline")
+	}
+
+	if htmlReport.Theme != helper.ReportThemeDark {
+		t.Fatalf("actual %v expected %v", htmlReport.Theme, helper.ReportThemeDark)
+	}
+}
+
+func TestNewReportHTMLInvalidOptions(t *testing.T) {
+	for _, config := range []string{"reports?offline=maybe", "reports?unknown=1", "reports?%zz"} {
+		_, err := backtest.NewReport(backtest.HTMLReportBuilderName, config)
+		if err == nil {
+			t.Fatalf("expected error for %s", config)
+		}
+	}
+}
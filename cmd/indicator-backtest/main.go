@@ -16,6 +16,7 @@ import (
 	"github.com/cinar/indicator/v2/strategy"
 	"github.com/cinar/indicator/v2/strategy/compound"
 	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/pattern"
 	"github.com/cinar/indicator/v2/strategy/trend"
 	"github.com/cinar/indicator/v2/strategy/volatility"
 	"github.com/cinar/indicator/v2/strategy/volume"
@@ -69,6 +70,7 @@ func main() {
 	backtester.Names = append(backtester.Names, flag.Args()...)
 	backtester.Strategies = append(backtester.Strategies, compound.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, momentum.AllStrategies()...)
+	backtester.Strategies = append(backtester.Strategies, pattern.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, strategy.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, trend.AllStrategies()...)
 	backtester.Strategies = append(backtester.Strategies, volatility.AllStrategies()...)
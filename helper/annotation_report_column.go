@@ -44,3 +44,8 @@ func (c *annotationReportColumn) Value() string {
 
 	return "null"
 }
+
+// cell returns the next typed value for the report column.
+func (c *annotationReportColumn) cell() reportCell {
+	return reportCell{text: <-c.values}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// AverageTrueRange computes the Average True Range (ATR) as the simple moving
+// average of the true ranges, one period at a time, for the callers that need
+// the ATR along with their own state, such as the ATR based ZigZag and Renko.
+//
+//	TR = Max((High - Low), (High - Previous Closing), (Previous Closing - Low))
+//	ATR = SMA(TR, Period)
+//
+// Example:
+//
+//	atr := helper.NewAverageTrueRange[float64](14)
+//	value, ok := atr.Add(high, low, closing)
+type AverageTrueRange[T Number] struct {
+	period          int
+	ranges          *Ring[T]
+	sum             T
+	previousClosing T
+	started         bool
+}
+
+// NewAverageTrueRange function initializes a new Average True Range instance
+// with the given period.
+func NewAverageTrueRange[T Number](period int) *AverageTrueRange[T] {
+	return &AverageTrueRange[T]{
+		period: period,
+		ranges: NewRing[T](period),
+	}
+}
+
+// Add adds the given high, low, and closing of the next period, and returns
+// the ATR once a full period of true ranges is available. The first period
+// only provides the previous closing.
+func (a *AverageTrueRange[T]) Add(high, low, closing T) (T, bool) {
+	previousClosing, started := a.previousClosing, a.started
+	a.previousClosing, a.started = closing, true
+
+	if !started {
+		return 0, false
+	}
+
+	tr := max(high-low, high-previousClosing, previousClosing-low)
+
+	a.sum -= a.ranges.Put(tr)
+	a.sum += tr
+
+	if !a.ranges.IsFull() {
+		return 0, false
+	}
+
+	return a.sum / T(a.period), true
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestAverageTrueRange(t *testing.T) {
+	highs := []float64{10, 12, 11, 15}
+	lows := []float64{8, 9, 7, 12}
+	closings := []float64{9, 11, 8, 14}
+
+	expected := []float64{0, 0, 3.5, 5.5}
+	expectedOk := []bool{false, false, true, true}
+
+	atr := helper.NewAverageTrueRange[float64](2)
+
+	for i := range highs {
+		actual, ok := atr.Add(highs[i], lows[i], closings[i])
+		if actual != expected[i] || ok != expectedOk[i] {
+			t.Fatalf("index %d actual %v %v expected %v %v", i, actual, ok, expected[i], expectedOk[i])
+		}
+	}
+}
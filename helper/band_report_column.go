@@ -0,0 +1,64 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "fmt"
+
+// bandReportColumn is the band report column struct.
+type bandReportColumn[T Number] struct {
+	ReportColumn
+	name   string
+	lowers <-chan T
+	uppers <-chan T
+}
+
+// NewBandReportColumn returns a new instance of a band column for a report,
+// shading the area between the lower and the upper values, such as for the
+// Bollinger Bands, the Keltner Channel, or the Ichimoku Cloud. Similar to the
+// annotations, the band is attached to the data column preceding it.
+func NewBandReportColumn[T Number](name string, lowers, uppers <-chan T) ReportColumn {
+	return &bandReportColumn[T]{
+		name:   name,
+		lowers: lowers,
+		uppers: uppers,
+	}
+}
+
+// Name returns the name of the report column.
+func (c *bandReportColumn[T]) Name() string {
+	return c.name
+}
+
+// Type returns number as the data type.
+func (*bandReportColumn[T]) Type() string {
+	return "number"
+}
+
+// Role returns the role of the report column.
+func (*bandReportColumn[T]) Role() string {
+	return "interval"
+}
+
+// Style returns band as the series style.
+func (*bandReportColumn[T]) Style() string {
+	return ReportStyleBand
+}
+
+// Cells returns the number of values, which are the lower and the upper values.
+func (*bandReportColumn[T]) Cells() int {
+	return 2
+}
+
+// Value returns the next data value for the report column.
+func (c *bandReportColumn[T]) Value() string {
+	lower, upper := <-c.lowers, <-c.uppers
+	return fmt.Sprintf("%v, %v", lower, upper)
+}
+
+// cell returns the next typed value for the report column.
+func (c *bandReportColumn[T]) cell() reportCell {
+	lower, upper := <-c.lowers, <-c.uppers
+	return reportCell{numbers: []float64{float64(lower), float64(upper)}}
+}
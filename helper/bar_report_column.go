@@ -0,0 +1,59 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "fmt"
+
+// barReportColumn is the bar report column struct.
+type barReportColumn[T Number] struct {
+	ReportColumn
+	name   string
+	values <-chan T
+}
+
+// NewBarReportColumn returns a new instance of a bar column for a report, such
+// as for the volumes. The bars start from zero, so they are usually placed on
+// a separate chart from the prices.
+func NewBarReportColumn[T Number](name string, values <-chan T) ReportColumn {
+	return &barReportColumn[T]{
+		name:   name,
+		values: values,
+	}
+}
+
+// Name returns the name of the report column.
+func (c *barReportColumn[T]) Name() string {
+	return c.name
+}
+
+// Type returns number as the data type.
+func (*barReportColumn[T]) Type() string {
+	return "number"
+}
+
+// Role returns the role of the report column.
+func (*barReportColumn[T]) Role() string {
+	return "data"
+}
+
+// Style returns bars as the series style.
+func (*barReportColumn[T]) Style() string {
+	return ReportStyleBars
+}
+
+// Cells returns the number of values.
+func (*barReportColumn[T]) Cells() int {
+	return 1
+}
+
+// Value returns the next data value for the report column.
+func (c *barReportColumn[T]) Value() string {
+	return fmt.Sprintf("%v", <-c.values)
+}
+
+// cell returns the next typed value for the report column.
+func (c *barReportColumn[T]) cell() reportCell {
+	return reportCell{numbers: []float64{float64(<-c.values)}}
+}
@@ -22,3 +22,47 @@ func BufferedWithContext[T any](ctx context.Context, c <-chan T, size int) <-cha
 
 	return result
 }
+
+// UnboundedWithContext reads the given channel eagerly into an unbounded
+// buffer, and emits its values at the pace of the consumer, so that the
+// producer is never blocked by the consumer, supporting context cancellation.
+func UnboundedWithContext[T any](ctx context.Context, c <-chan T) <-chan T {
+	result := make(chan T)
+
+	go func() {
+		defer close(result)
+
+		var queue []T
+
+		for c != nil || len(queue) > 0 {
+			// Sending is only enabled when there is a value to send.
+			var output chan T
+			var head T
+
+			if len(queue) > 0 {
+				output = result
+				head = queue[0]
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+
+			case value, ok := <-c:
+				if !ok {
+					c = nil
+					continue
+				}
+
+				queue = append(queue, value)
+
+			case output <- head:
+				var zero T
+				queue[0] = zero
+				queue = queue[1:]
+			}
+		}
+	}()
+
+	return result
+}
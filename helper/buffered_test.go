@@ -5,6 +5,7 @@
 package helper_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -23,3 +24,20 @@ func TestBuffered(_ *testing.T) {
 
 	helper.Drain(b)
 }
+
+func TestUnbounded(t *testing.T) {
+	c := make(chan int)
+	u := helper.UnboundedWithContext(context.Background(), c)
+
+	// The producer is not blocked by the consumer.
+	for i := 1; i <= 4; i++ {
+		c <- i
+	}
+
+	close(c)
+
+	err := helper.CheckEquals(u, helper.SliceToChan([]int{1, 2, 3, 4}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
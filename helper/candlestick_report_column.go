@@ -0,0 +1,70 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "fmt"
+
+// candlestickReportColumn is the candlestick report column struct.
+type candlestickReportColumn[T Number] struct {
+	ReportColumn
+	name   string
+	opens  <-chan T
+	highs  <-chan T
+	lows   <-chan T
+	closes <-chan T
+}
+
+// NewCandlestickReportColumn returns a new instance of a candlestick column for
+// a report, drawing the opening, high, low, and closing values of each period.
+// The buy and sell annotations following it are placed below and above the
+// candles as markers.
+func NewCandlestickReportColumn[T Number](name string, opens, highs, lows, closes <-chan T) ReportColumn {
+	return &candlestickReportColumn[T]{
+		name:   name,
+		opens:  opens,
+		highs:  highs,
+		lows:   lows,
+		closes: closes,
+	}
+}
+
+// Name returns the name of the report column.
+func (c *candlestickReportColumn[T]) Name() string {
+	return c.name
+}
+
+// Type returns number as the data type.
+func (*candlestickReportColumn[T]) Type() string {
+	return "number"
+}
+
+// Role returns the role of the report column.
+func (*candlestickReportColumn[T]) Role() string {
+	return "data"
+}
+
+// Style returns candlesticks as the series style.
+func (*candlestickReportColumn[T]) Style() string {
+	return ReportStyleCandlesticks
+}
+
+// Cells returns the number of values, which are the low, open, close, and
+// high values.
+func (*candlestickReportColumn[T]) Cells() int {
+	return 4
+}
+
+// Value returns the next data value for the report column.
+func (c *candlestickReportColumn[T]) Value() string {
+	open, high, low, closing := <-c.opens, <-c.highs, <-c.lows, <-c.closes
+	return fmt.Sprintf("%v, %v, %v, %v", low, open, closing, high)
+}
+
+// cell returns the next typed value for the report column, in the same order
+// as its Value.
+func (c *candlestickReportColumn[T]) cell() reportCell {
+	open, high, low, closing := <-c.opens, <-c.highs, <-c.lows, <-c.closes
+	return reportCell{numbers: []float64{float64(low), float64(open), float64(closing), float64(high)}}
+}
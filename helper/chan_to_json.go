@@ -5,6 +5,7 @@
 package helper
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 )
@@ -21,6 +22,13 @@ import (
 //	fmt.Println(buffer.String())
 //	// Output: [2,4,6,8,9]
 func ChanToJSON[T any](c <-chan T, w io.Writer) error {
+	return ChanToJSONWithContext(context.Background(), c, w)
+}
+
+// ChanToJSONWithContext converts a channel of values into a JSON array and writes it to the
+// specified writer as the values arrive, supporting context cancellation. The array is left
+// unterminated if the context is canceled.
+func ChanToJSONWithContext[T any](ctx context.Context, c <-chan T, w io.Writer) error {
 	first := true
 
 	_, err := w.Write([]byte{'['})
@@ -28,7 +36,20 @@ func ChanToJSON[T any](c <-chan T, w io.Writer) error {
 		return err
 	}
 
-	for n := range c {
+	for {
+		var n T
+		var ok bool
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n, ok = <-c:
+		}
+
+		if !ok {
+			break
+		}
+
 		if !first {
 			_, err = w.Write([]byte{','})
 			if err != nil {
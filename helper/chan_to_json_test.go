@@ -6,6 +6,8 @@ package helper_test
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -27,3 +29,15 @@ func TestChanToJSON(t *testing.T) {
 		t.Fatalf("actual=%s expected=%s", actual, expected)
 	}
 }
+
+func TestChanToJSONWithContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var buffer bytes.Buffer
+
+	err := helper.ChanToJSONWithContext(ctx, make(chan int), &buffer)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("actual %v expected %v", err, context.Canceled)
+	}
+}
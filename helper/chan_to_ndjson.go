@@ -0,0 +1,45 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// ChanToNDJSONWithContext converts a channel of values into newline delimited JSON (NDJSON)
+// format, one value per line, and writes it to the specified writer as the values arrive,
+// supporting context cancellation.
+//
+// Example:
+//
+//	input := helper.SliceToChan([]int{2, 4, 6, 8})
+//
+//	var buffer bytes.Buffer
+//	err := helper.ChanToNDJSONWithContext(ctx, input, &buffer)
+//
+//	fmt.Println(buffer.String())
+//	// Output: 2\n4\n6\n8\n
+func ChanToNDJSONWithContext[T any](ctx context.Context, c <-chan T, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case n, ok := <-c:
+			if !ok {
+				return nil
+			}
+
+			err := encoder.Encode(n)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
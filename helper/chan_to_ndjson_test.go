@@ -0,0 +1,57 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestChanToNDJSON(t *testing.T) {
+	type Row struct {
+		Name  string
+		Value int
+	}
+
+	input := helper.SliceToChan([]Row{{"a", 1}, {"b", 2}})
+	expected := "{\"Name\":\"a\",\"Value\":1}\n{\"Name\":\"b\",\"Value\":2}\n"
+
+	var buffer bytes.Buffer
+
+	err := helper.ChanToNDJSONWithContext(context.Background(), input, &buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := buffer.String()
+	if actual != expected {
+		t.Fatalf("actual=%s expected=%s", actual, expected)
+	}
+}
+
+func TestChanToNDJSONCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var buffer bytes.Buffer
+
+	err := helper.ChanToNDJSONWithContext(ctx, make(chan int), &buffer)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("actual %v expected %v", err, context.Canceled)
+	}
+}
+
+func TestChanToNDJSONUnsupported(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := helper.ChanToNDJSONWithContext(context.Background(), helper.SliceToChan([]func(){func() {}}), &buffer)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+const (
+	// DefaultChunkSize is the default number of values in a chunk.
+	DefaultChunkSize = 256
+)
+
+// ChunkWithContext groups the values of the given channel into chunks of the
+// given size, and sends them to the returned channel, supporting context
+// cancellation. The last chunk may be shorter. If the size is not positive,
+// the DefaultChunkSize is used.
+//
+// The chunked transport passes a batch of values with each channel send, which
+// reduces the goroutine handoffs for the long series. The chunks are shared
+// between the pipeline stages, so they must not be modified once sent.
+//
+// Example:
+//
+//	chunks := helper.ChunkWithContext(ctx, values, 1024)
+//	chunks = helper.MapChunksWithContext(ctx, chunks, math.Sqrt)
+//	values = helper.UnchunkWithContext(ctx, chunks)
+func ChunkWithContext[T any](ctx context.Context, c <-chan T, size int) <-chan []T {
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+
+	result := make(chan []T)
+
+	go func() {
+		defer close(result)
+
+		chunk := make([]T, 0, size)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case n, ok := <-c:
+				if !ok {
+					sendChunkWithContext(ctx, result, chunk)
+					return
+				}
+
+				chunk = append(chunk, n)
+
+				if len(chunk) == size {
+					if !sendChunkWithContext(ctx, result, chunk) {
+						return
+					}
+
+					chunk = make([]T, 0, size)
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// UnchunkWithContext sends the values of the chunks of the given channel one
+// by one to the returned channel, supporting context cancellation.
+func UnchunkWithContext[T any](ctx context.Context, c <-chan []T) <-chan T {
+	result := make(chan T)
+
+	go func() {
+		defer close(result)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case chunk, ok := <-c:
+				if !ok {
+					return
+				}
+
+				for _, n := range chunk {
+					select {
+					case <-ctx.Done():
+						return
+					case result <- n:
+					}
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// SliceToChunks returns a channel with the given slice split into chunks of
+// the given size. The chunks share the memory of the slice.
+func SliceToChunks[T any](slice []T, size int) <-chan []T {
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+
+	result := make(chan []T)
+
+	go func() {
+		defer close(result)
+
+		for len(slice) > 0 {
+			n := min(size, len(slice))
+			result <- slice[:n:n]
+			slice = slice[n:]
+		}
+	}()
+
+	return result
+}
+
+// ChunksToSlice collects the values of the chunks of the given channel into
+// a slice.
+func ChunksToSlice[T any](c <-chan []T) []T {
+	var result []T
+
+	for chunk := range c {
+		result = append(result, chunk...)
+	}
+
+	return result
+}
+
+// sendChunkWithContext sends the given chunk unless it is empty, and returns
+// false if the context is canceled.
+func sendChunkWithContext[T any](ctx context.Context, c chan<- []T, chunk []T) bool {
+	if len(chunk) == 0 {
+		return true
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case c <- chunk:
+		return true
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestChunk(t *testing.T) {
+	ctx := context.Background()
+
+	input := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7})
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+
+	actual := helper.ChanToSlice(helper.ChunkWithContext(ctx, input, 3))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestChunkDefaultSize(t *testing.T) {
+	ctx := context.Background()
+
+	input := make([]int, helper.DefaultChunkSize+1)
+
+	actual := helper.ChanToSlice(helper.ChunkWithContext(ctx, helper.SliceToChan(input), 0))
+
+	if len(actual) != 2 || len(actual[0]) != helper.DefaultChunkSize || len(actual[1]) != 1 {
+		t.Fatalf("actual %v chunks", len(actual))
+	}
+}
+
+func TestUnchunk(t *testing.T) {
+	ctx := context.Background()
+
+	input := helper.SliceToChunks([]int{1, 2, 3, 4, 5}, 2)
+	expected := helper.SliceToChan([]int{1, 2, 3, 4, 5})
+
+	actual := helper.UnchunkWithContext(ctx, input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChunkCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	input := make(chan int)
+	defer close(input)
+
+	actual := helper.ChanToSlice(helper.ChunkWithContext(ctx, input, 2))
+	if len(actual) != 0 {
+		t.Fatalf("actual %v expected []", actual)
+	}
+}
+
+func TestMapChunks(t *testing.T) {
+	ctx := context.Background()
+
+	input := helper.SliceToChunks([]int{1, 2, 3, 4, 5}, 2)
+	expected := []int{2, 4, 6, 8, 10}
+
+	actual := helper.ChunksToSlice(helper.MapChunksWithContext(ctx, input, func(n int) int {
+		return n * 2
+	}))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestFilterMapChunks(t *testing.T) {
+	ctx := context.Background()
+
+	input := helper.SliceToChunks([]int{1, 3, 2, 4, 5}, 2)
+	expected := [][]int{{2, 4}, {5}}
+
+	actual := helper.ChanToSlice(helper.FilterMapChunksWithContext(ctx, input, func(n int) (int, bool) {
+		return n, n != 1 && n != 3
+	}))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestOperateChunks(t *testing.T) {
+	ctx := context.Background()
+
+	a := helper.SliceToChunks([]int{1, 2, 3, 4, 5, 6}, 4)
+	b := helper.SliceToChunks([]int{10, 20, 30, 40, 50}, 3)
+	expected := []int{11, 22, 33, 44, 55}
+
+	actual := helper.ChunksToSlice(helper.OperateChunksWithContext(ctx, a, b, func(a, b int) int {
+		return a + b
+	}))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestWindowChunks(t *testing.T) {
+	ctx := context.Background()
+
+	values := []int{1, 2, 3, 4, 5, 6, 7}
+
+	sum := func(w []int, _ int) int {
+		total := 0
+		for _, n := range w {
+			total += n
+		}
+
+		return total
+	}
+
+	expected := helper.WindowWithContext(ctx, helper.SliceToChan(values), sum, 3)
+	actual := helper.UnchunkWithContext(ctx, helper.WindowChunksWithContext(ctx, helper.SliceToChunks(values, 2), sum, 3))
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDuplicateChunks(t *testing.T) {
+	ctx := context.Background()
+
+	values := []int{1, 2, 3, 4, 5}
+
+	outputs := helper.DuplicateChunksWithContext(ctx, helper.SliceToChunks(values, 2), 2)
+
+	go helper.DrainWithContext(ctx, outputs[1])
+
+	actual := helper.ChunksToSlice(outputs[0])
+
+	if !reflect.DeepEqual(actual, values) {
+		t.Fatalf("actual %v expected %v", actual, values)
+	}
+}
+
+func TestComputeChunksFallback(t *testing.T) {
+	ctx := context.Background()
+
+	values := []float64{1, 2, 3, 4, 5}
+	indicator := &doubleIndicator{}
+
+	actual := helper.ChunksToSlice(helper.ComputeChunksWithContext[float64](ctx, indicator, helper.SliceToChunks(values, 2)))
+	expected := []float64{2, 4, 6, 8, 10}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+// doubleIndicator is a test indicator without a chunked implementation.
+type doubleIndicator struct{}
+
+func (*doubleIndicator) ComputeWithContext(ctx context.Context, c <-chan float64) <-chan float64 {
+	return helper.MapWithContext(ctx, c, func(n float64) float64 {
+		return n * 2
+	})
+}
+
+func (*doubleIndicator) IdlePeriod() int {
+	return 0
+}
+
+func benchmarkValues() []float64 {
+	values := make([]float64, 100_000)
+	for i := range values {
+		values[i] = float64(i)
+	}
+
+	return values
+}
+
+func BenchmarkMap(b *testing.B) {
+	ctx := context.Background()
+	values := benchmarkValues()
+
+	for i := 0; i < b.N; i++ {
+		helper.DrainWithContext(ctx, helper.MapWithContext(ctx, helper.SliceToChan(values), func(n float64) float64 {
+			return n * 2
+		}))
+	}
+}
+
+func BenchmarkMapChunks(b *testing.B) {
+	ctx := context.Background()
+	values := benchmarkValues()
+
+	for i := 0; i < b.N; i++ {
+		helper.DrainWithContext(ctx, helper.MapChunksWithContext(ctx, helper.SliceToChunks(values, helper.DefaultChunkSize), func(n float64) float64 {
+			return n * 2
+		}))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/csv"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"log/slog"
@@ -15,6 +16,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 const (
@@ -26,12 +29,19 @@ const (
 
 	// DefaultDateTimeFormat denotes the default format of a date and time column.
 	DefaultDateTimeFormat = "2006-01-02"
+
+	// UnixDateTimeFormat denotes a date and time column in seconds since the Unix epoch.
+	UnixDateTimeFormat = "unix"
+
+	// UnixMilliDateTimeFormat denotes a date and time column in milliseconds since the Unix epoch.
+	UnixMilliDateTimeFormat = "unixmilli"
 )
 
 // csvColumn represents the mapping between the CSV column and
 // the corresponding struct field.
 type csvColumn struct {
 	Header      string
+	Aliases     []string
 	ColumnIndex int
 	FieldIndex  int
 	Format      string
@@ -51,6 +61,30 @@ type Csv[T any] struct {
 
 	// defaultDateTimeFormat is the default format for date and time columns.
 	defaultDateTimeFormat string
+
+	// delimiter is the field delimiter.
+	delimiter rune
+
+	// comment is the comment character. Lines beginning with it are ignored.
+	comment rune
+
+	// location is the time zone for the date and time columns without one.
+	location *time.Location
+
+	// headers are the column headers overriding the ones from the struct tags, keyed by field name.
+	headers map[string]string
+
+	// aliases are the alternative column headers, keyed by field name.
+	aliases map[string][]string
+
+	// indexes are the column indexes used when the CSV has no header row, keyed by field name.
+	indexes map[string]int
+
+	// skipMalformedRows indicates whether the malformed rows are skipped instead of stopping.
+	skipMalformedRows bool
+
+	// skippedRows is the number of malformed rows skipped so far.
+	skippedRows atomic.Int64
 }
 
 // CsvOption represents a functional option for configuring the CSV instance.
@@ -77,12 +111,72 @@ func WithCsvDefaultDateTimeFormat[T any](format string) CsvOption[T] {
 	}
 }
 
+// WithCsvDelimiter sets the field delimiter, such as ';', for the CSV instance.
+func WithCsvDelimiter[T any](delimiter rune) CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.delimiter = delimiter
+	}
+}
+
+// WithCsvComment sets the comment character, such as '#', for the CSV instance. The lines
+// beginning with the comment character are ignored.
+func WithCsvComment[T any](comment rune) CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.comment = comment
+	}
+}
+
+// WithCsvLocation sets the time zone for the date and time columns that do not specify one,
+// such as the exchange local time. The epoch based columns are converted to the time zone.
+func WithCsvLocation[T any](location *time.Location) CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.location = location
+	}
+}
+
+// WithCsvColumnHeader remaps the struct field with the given name to the column with the given
+// header, overriding the header from the struct tag.
+func WithCsvColumnHeader[T any](field, header string) CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.headers[field] = header
+	}
+}
+
+// WithCsvHeaderAliases sets the alternative column headers, such as "Adj Close", for the struct
+// field with the given name. The first alias found in the header row is used when the column
+// header itself is not found.
+func WithCsvHeaderAliases[T any](field string, aliases ...string) CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.aliases[field] = append(c.aliases[field], aliases...)
+	}
+}
+
+// WithCsvColumnIndex remaps the struct field with the given name to the column at the given
+// index, when the CSV has no header row.
+func WithCsvColumnIndex[T any](field string, index int) CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.indexes[field] = index
+	}
+}
+
+// WithCsvSkipMalformedRows skips the malformed rows instead of stopping at the first one. The
+// number of skipped rows is logged and is available through the SkippedRows method.
+func WithCsvSkipMalformedRows[T any]() CsvOption[T] {
+	return func(c *Csv[T]) {
+		c.skipMalformedRows = true
+	}
+}
+
 // NewCsv creates a new CSV instance with the provided options.
 func NewCsv[T any](options ...CsvOption[T]) (*Csv[T], error) {
 	c := &Csv[T]{
 		hasHeader:             true,
 		Logger:                slog.Default(),
 		defaultDateTimeFormat: DefaultDateTimeFormat,
+		delimiter:             ',',
+		headers:               make(map[string]string),
+		aliases:               make(map[string][]string),
+		indexes:               make(map[string]int),
 	}
 
 	// Apply options to the CSV instance.
@@ -101,9 +195,17 @@ func NewCsv[T any](options ...CsvOption[T]) (*Csv[T], error) {
 	for i := 0; i < structType.NumField(); i++ {
 		field := structType.Field(i)
 
-		header, ok := field.Tag.Lookup(CsvHeaderTag)
+		header, ok := c.headers[field.Name]
 		if !ok {
-			header = field.Name
+			header, ok = field.Tag.Lookup(CsvHeaderTag)
+			if !ok {
+				header = field.Name
+			}
+		}
+
+		columnIndex, ok := c.indexes[field.Name]
+		if !ok {
+			columnIndex = i
 		}
 
 		format, ok := field.Tag.Lookup(CsvFormatTag)
@@ -113,7 +215,8 @@ func NewCsv[T any](options ...CsvOption[T]) (*Csv[T], error) {
 
 		c.columns[i] = csvColumn{
 			Header:      header,
-			ColumnIndex: i,
+			Aliases:     c.aliases[field.Name],
+			ColumnIndex: columnIndex,
 			FieldIndex:  i,
 			Format:      format,
 		}
@@ -138,7 +241,7 @@ func (c *Csv[T]) ReadFromReaderWithContext(ctx context.Context, reader io.Reader
 	go func() {
 		defer close(rows)
 
-		csvReader := csv.NewReader(reader)
+		csvReader := c.newCsvReader(reader)
 
 		// If CSV has headers, align column indices to match the
 		// order of column headers.
@@ -150,6 +253,13 @@ func (c *Csv[T]) ReadFromReaderWithContext(ctx context.Context, reader io.Reader
 			}
 		}
 
+		skipped := 0
+		defer func() {
+			if skipped > 0 {
+				c.Logger.Warn("Skipped malformed rows.", "count", skipped)
+			}
+		}()
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -163,24 +273,27 @@ func (c *Csv[T]) ReadFromReaderWithContext(ctx context.Context, reader io.Reader
 			}
 
 			if err != nil {
+				var parseErr *csv.ParseError
+				if c.skipMalformedRows && errors.As(err, &parseErr) {
+					skipped++
+					c.skippedRows.Add(1)
+					continue
+				}
+
 				c.Logger.Error("Unable to read row.", "error", err)
 				break
 			}
 
-			row := new(T)
-			rowValue := reflect.ValueOf(row).Elem()
-
-			for _, column := range c.columns {
-				if column.ColumnIndex == -1 {
+			row, err := c.parseRecord(record)
+			if err != nil {
+				if c.skipMalformedRows {
+					skipped++
+					c.skippedRows.Add(1)
 					continue
 				}
 
-				err := setReflectValue(rowValue.Field(column.FieldIndex),
-					record[column.ColumnIndex], column.Format)
-				if err != nil {
-					c.Logger.Error("Unable to set value.", "error", err)
-					return
-				}
+				c.Logger.Error("Unable to set value.", "error", err)
+				return
 			}
 
 			select {
@@ -194,6 +307,11 @@ func (c *Csv[T]) ReadFromReaderWithContext(ctx context.Context, reader io.Reader
 	return rows
 }
 
+// SkippedRows returns the number of malformed rows skipped so far by the CSV instance.
+func (c *Csv[T]) SkippedRows() int {
+	return int(c.skippedRows.Load())
+}
+
 // ReadFromFile parses the CSV data from the provided file name,
 // maps the data to corresponding struct fields, and delivers
 // the resulting rows through the channel.
@@ -284,6 +402,15 @@ func (c *Csv[T]) updateColumnIndexes(csvReader *csv.Reader) error {
 
 	for i := range c.columns {
 		index, ok := headerMap[c.columns[i].Header]
+
+		for _, alias := range c.columns[i].Aliases {
+			if ok {
+				break
+			}
+
+			index, ok = headerMap[alias]
+		}
+
 		if !ok {
 			index = -1
 		}
@@ -294,10 +421,49 @@ func (c *Csv[T]) updateColumnIndexes(csvReader *csv.Reader) error {
 	return nil
 }
 
+// newCsvReader creates a new CSV reader for the given reader using the configured options.
+func (c *Csv[T]) newCsvReader(reader io.Reader) *csv.Reader {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comma = c.delimiter
+	csvReader.Comment = c.comment
+
+	// Rows with the wrong number of fields are checked per column when skipping.
+	if c.skipMalformedRows {
+		csvReader.FieldsPerRecord = -1
+	}
+
+	return csvReader
+}
+
+// parseRecord maps the given record to the corresponding struct fields.
+func (c *Csv[T]) parseRecord(record []string) (*T, error) {
+	row := new(T)
+	rowValue := reflect.ValueOf(row).Elem()
+
+	for _, column := range c.columns {
+		if column.ColumnIndex == -1 {
+			continue
+		}
+
+		if column.ColumnIndex >= len(record) {
+			return nil, fmt.Errorf("missing column %s", column.Header)
+		}
+
+		err := setReflectValueInLocation(rowValue.Field(column.FieldIndex),
+			record[column.ColumnIndex], column.Format, c.location)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return row, nil
+}
+
 // writeToWriterWithContext writes the provided rows of data to the specified writer, with the option
 // to include or exclude headers for flexibility in data presentation, supporting context cancellation.
 func (c *Csv[T]) writeToWriterWithContext(ctx context.Context, writer io.Writer, writeHeader bool, rows <-chan *T) error {
 	csvWriter := csv.NewWriter(writer)
+	csvWriter.Comma = c.delimiter
 
 	if writeHeader {
 		err := c.writeHeaderToCsvWriter(csvWriter)
@@ -329,7 +495,7 @@ func (c *Csv[T]) writeToWriterWithContext(ctx context.Context, writer io.Writer,
 		rowValue := reflect.ValueOf(row).Elem()
 
 		for i, column := range c.columns {
-			stringValue, err := getReflectValue(rowValue.Field(column.FieldIndex), column.Format)
+			stringValue, err := getReflectValueInLocation(rowValue.Field(column.FieldIndex), column.Format, c.location)
 			if err != nil {
 				return err
 			}
@@ -417,3 +417,168 @@ func TestCsvWithDefaultDateFormat(t *testing.T) {
 		t.Fatalf("actual %v expected %v", row.Date, expected)
 	}
 }
+
+func TestCsvWithHeaderAliases(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64 `header:"Max"`
+	}
+
+	reader := strings.NewReader("Date,Adj Close,Highest\n2023-11-26,30.4,31.5\n")
+
+	csv, err := helper.NewCsv[Row](
+		helper.WithCsvHeaderAliases[Row]("Close", "Last", "Adj Close"),
+		helper.WithCsvColumnHeader[Row]("High", "Highest"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := <-csv.ReadFromReader(reader)
+
+	if row.Close != 30.4 || row.High != 31.5 {
+		t.Fatalf("actual %v expected {30.4 31.5}", row)
+	}
+}
+
+func TestCsvWithColumnIndex(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	reader := strings.NewReader("2023-11-26,31.5,30.4\n")
+
+	csv, err := helper.NewCsv[Row](
+		helper.WithoutCsvHeader[Row](),
+		helper.WithCsvColumnIndex[Row]("Close", 2),
+		helper.WithCsvColumnIndex[Row]("High", 1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := <-csv.ReadFromReader(reader)
+
+	if row.Close != 30.4 || row.High != 31.5 {
+		t.Fatalf("actual %v expected {30.4 31.5}", row)
+	}
+}
+
+func TestCsvWithDelimiterAndComment(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	reader := strings.NewReader("# Exported data\nClose;High\n# Holiday\n30.4;31.5\n")
+
+	csv, err := helper.NewCsv[Row](
+		helper.WithCsvDelimiter[Row](';'),
+		helper.WithCsvComment[Row]('#'),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(csv.ReadFromReader(reader))
+
+	if len(rows) != 1 || rows[0].Close != 30.4 || rows[0].High != 31.5 {
+		t.Fatalf("actual %v expected [{30.4 31.5}]", rows)
+	}
+}
+
+func TestCsvWithUnixDateFormat(t *testing.T) {
+	type Row struct {
+		Date      time.Time
+		Timestamp time.Time `format:"unixmilli"`
+	}
+
+	reader := strings.NewReader("Date,Timestamp\n1700956800,1700956800123\n")
+
+	csv, err := helper.NewCsv[Row](
+		helper.WithCsvDefaultDateTimeFormat[Row](helper.UnixDateTimeFormat),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := <-csv.ReadFromReader(reader)
+
+	expected := time.Date(2023, 11, 26, 0, 0, 0, 0, time.UTC)
+
+	if !row.Date.Equal(expected) {
+		t.Fatalf("actual %v expected %v", row.Date, expected)
+	}
+
+	if !row.Timestamp.Equal(expected.Add(123 * time.Millisecond)) {
+		t.Fatalf("actual %v expected %v", row.Timestamp, expected.Add(123*time.Millisecond))
+	}
+}
+
+func TestCsvWithLocation(t *testing.T) {
+	type Row struct {
+		Date time.Time `format:"2006-01-02 15:04"`
+	}
+
+	location := time.FixedZone("EST", -5*60*60)
+
+	csv, err := helper.NewCsv[Row](
+		helper.WithCsvLocation[Row](location),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := <-csv.ReadFromReader(strings.NewReader("Date\n2023-11-26 09:30\n"))
+
+	expected := time.Date(2023, 11, 26, 14, 30, 0, 0, time.UTC)
+
+	if !row.Date.Equal(expected) {
+		t.Fatalf("actual %v expected %v", row.Date, expected)
+	}
+
+	fileName := "test_csv_with_location.csv"
+	defer helper.Remove(t, fileName)
+
+	err = csv.WriteToFile(fileName, helper.SliceToChan([]*Row{{Date: expected}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.ReadFromFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := <-rows
+	if !actual.Date.Equal(expected) {
+		t.Fatalf("actual %v expected %v", actual.Date, expected)
+	}
+}
+
+func TestCsvSkipMalformedRows(t *testing.T) {
+	type Row struct {
+		Close float64
+		High  float64
+	}
+
+	reader := strings.NewReader("Close,High\n1,2\nabc,3\n4\n\"5,6\n")
+
+	csv, err := helper.NewCsv[Row](
+		helper.WithCsvSkipMalformedRows[Row](),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(csv.ReadFromReader(reader))
+
+	if len(rows) != 1 || rows[0].Close != 1 {
+		t.Fatalf("actual %v expected [{1 2}]", rows)
+	}
+
+	if csv.SkippedRows() != 3 {
+		t.Fatalf("actual %v expected 3", csv.SkippedRows())
+	}
+}
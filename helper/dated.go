@@ -0,0 +1,219 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"time"
+)
+
+// Dated represents a value along with its date.
+type Dated[T any] struct {
+	// Date is the date of the value.
+	Date time.Time
+
+	// Value is the value.
+	Value T
+}
+
+// DatedIndicator defines the shared interface of the single input indicators
+// that can be computed over the dated series.
+//
+// Deprecated: Use Indicator instead.
+type DatedIndicator[T any] interface {
+	Indicator[T]
+}
+
+// NewDated function initializes a new dated value.
+func NewDated[T any](date time.Time, value T) Dated[T] {
+	return Dated[T]{
+		Date:  date,
+		Value: value,
+	}
+}
+
+// ZipDatedWithContext combines the given dates and values into a dated series,
+// supporting context cancellation.
+func ZipDatedWithContext[T any](ctx context.Context, dates <-chan time.Time, values <-chan T) <-chan Dated[T] {
+	return OperateWithContext(ctx, dates, values, NewDated[T])
+}
+
+// UnzipDatedWithContext splits the given dated series into its dates and values,
+// supporting context cancellation.
+func UnzipDatedWithContext[T any](ctx context.Context, c <-chan Dated[T]) (<-chan time.Time, <-chan T) {
+	splice := DuplicateWithContext(ctx, c, 2)
+
+	dates := MapWithContext(ctx, splice[0], func(d Dated[T]) time.Time {
+		return d.Date
+	})
+
+	values := MapWithContext(ctx, splice[1], func(d Dated[T]) T {
+		return d.Value
+	})
+
+	return dates, values
+}
+
+// RedateWithContext attaches the given dates to the values computed by an
+// indicator with the given idle period. The first idle period dates are
+// skipped, so that each value gets the date of the input it was computed
+// at, supporting context cancellation.
+func RedateWithContext[T any](ctx context.Context, dates <-chan time.Time, values <-chan T, idlePeriod int) <-chan Dated[T] {
+	return ZipDatedWithContext(ctx, SkipWithContext(ctx, dates, idlePeriod), values)
+}
+
+// ComputeDatedWithContext runs the given function with the given idle period
+// over the values of the dated series, and re-attaches the correct dates to
+// its results, supporting context cancellation.
+//
+// Example:
+//
+//	rsi := momentum.NewRsi[float64]()
+//	rsis := helper.ComputeDatedWithContext(ctx, closings, rsi.IdlePeriod(), func(c <-chan float64) <-chan float64 {
+//		return rsi.ComputeWithContext(ctx, c)
+//	})
+func ComputeDatedWithContext[T, R any](ctx context.Context, c <-chan Dated[T], idlePeriod int, f func(<-chan T) <-chan R) <-chan Dated[R] {
+	dates, values := UnzipDatedWithContext(ctx, c)
+	return RedateWithContext(ctx, dates, f(values), idlePeriod)
+}
+
+// ComputeDatedIndicatorWithContext runs the given indicator over the values of
+// the dated series, and re-attaches the correct dates to its results,
+// supporting context cancellation.
+//
+// Example:
+//
+//	emas := helper.ComputeDatedIndicatorWithContext(ctx, trend.NewEma[float64](), closings)
+func ComputeDatedIndicatorWithContext[T any](ctx context.Context, indicator Indicator[T], c <-chan Dated[T]) <-chan Dated[T] {
+	return ComputeDatedWithContext(ctx, c, indicator.IdlePeriod(), func(values <-chan T) <-chan T {
+		return indicator.ComputeWithContext(ctx, values)
+	})
+}
+
+// JoinDatedWithContext aligns the given dated series by their dates, and emits
+// the values of all series for each date that exists in every one of them.
+// The series are expected to be in ascending date order. The values are in
+// the same order as the series, supporting context cancellation.
+//
+// The series are read independently of each other, and the values of the
+// series that are ahead are buffered in memory. This allows joining the
+// series computed from the same duplicated input with different idle
+// periods, such as the closings and their EMA.
+func JoinDatedWithContext[T any](ctx context.Context, inputs ...<-chan Dated[T]) <-chan Dated[[]T] {
+	result := make(chan Dated[[]T])
+
+	buffered := make([]<-chan Dated[T], len(inputs))
+	for i, input := range inputs {
+		buffered[i] = UnboundedWithContext(ctx, input)
+	}
+
+	inputs = buffered
+
+	go func() {
+		defer close(result)
+
+		// Drain the remaining values, so that the series that are ahead are not blocked.
+		defer func() {
+			for _, input := range inputs {
+				go DrainWithContext(ctx, input)
+			}
+		}()
+
+		if len(inputs) == 0 {
+			return
+		}
+
+		heads := make([]Dated[T], len(inputs))
+
+		// receive reads the next value of the given series.
+		receive := func(i int) bool {
+			select {
+			case <-ctx.Done():
+				return false
+
+			case head, ok := <-inputs[i]:
+				if !ok {
+					return false
+				}
+
+				heads[i] = head
+				return true
+			}
+		}
+
+		for i := range inputs {
+			if !receive(i) {
+				return
+			}
+		}
+
+		for {
+			// Find the latest date among the heads.
+			latest := heads[0].Date
+			for _, head := range heads[1:] {
+				if head.Date.After(latest) {
+					latest = head.Date
+				}
+			}
+
+			// Advance the series that are behind the latest date.
+			aligned := true
+			for i := range heads {
+				for heads[i].Date.Before(latest) {
+					if !receive(i) {
+						return
+					}
+				}
+
+				if !heads[i].Date.Equal(latest) {
+					aligned = false
+				}
+			}
+
+			if !aligned {
+				continue
+			}
+
+			values := make([]T, len(heads))
+			for i, head := range heads {
+				values[i] = head.Value
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case result <- NewDated(latest, values):
+			}
+
+			for i := range inputs {
+				if !receive(i) {
+					return
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// AlignDatedWithContext aligns the given dated series by their dates, and
+// returns a new dated series for each one of them containing only the dates
+// that exist in every one of them, supporting context cancellation.
+//
+// Example:
+//
+//	aligned := helper.AlignDatedWithContext(ctx, spyClosings, qqqClosings)
+func AlignDatedWithContext[T any](ctx context.Context, inputs ...<-chan Dated[T]) []<-chan Dated[T] {
+	joined := DuplicateWithContext(ctx, JoinDatedWithContext(ctx, inputs...), len(inputs))
+	outputs := make([]<-chan Dated[T], len(inputs))
+
+	for i := range inputs {
+		outputs[i] = MapWithContext(ctx, joined[i], func(d Dated[[]T]) Dated[T] {
+			return NewDated(d.Date, d.Value[i])
+		})
+	}
+
+	return outputs
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// day returns the date for the given day of January 2024.
+func day(n int) time.Time {
+	return time.Date(2024, time.January, n, 0, 0, 0, 0, time.UTC)
+}
+
+// datedSum is a simple indicator for testing that sums the last two values.
+type datedSum struct{}
+
+func (datedSum) ComputeWithContext(ctx context.Context, c <-chan int) <-chan int {
+	sums := helper.WindowWithContext(ctx, c, func(w []int, _ int) int {
+		sum := 0
+		for _, n := range w {
+			sum += n
+		}
+
+		return sum
+	}, 2)
+
+	return helper.SkipWithContext(ctx, sums, 1)
+}
+
+func (datedSum) IdlePeriod() int {
+	return 1
+}
+
+func TestZipAndUnzipDated(t *testing.T) {
+	ctx := context.Background()
+
+	dates := []time.Time{day(1), day(2), day(3)}
+	values := []int{1, 2, 3}
+
+	dated := helper.ChanToSlice(helper.ZipDatedWithContext(ctx, helper.SliceToChan(dates), helper.SliceToChan(values)))
+
+	expected := []helper.Dated[int]{
+		helper.NewDated(day(1), 1),
+		helper.NewDated(day(2), 2),
+		helper.NewDated(day(3), 3),
+	}
+
+	if !reflect.DeepEqual(dated, expected) {
+		t.Fatalf("actual %v expected %v", dated, expected)
+	}
+
+	actualDates, actualValues := helper.UnzipDatedWithContext(ctx, helper.SliceToChan(dated))
+
+	for i := range dates {
+		actualDate := <-actualDates
+		actualValue := <-actualValues
+
+		if !actualDate.Equal(dates[i]) || actualValue != values[i] {
+			t.Fatalf("actual %v %v expected %v %v", actualDate, actualValue, dates[i], values[i])
+		}
+	}
+}
+
+func TestComputeDatedIndicator(t *testing.T) {
+	ctx := context.Background()
+
+	input := []helper.Dated[int]{
+		helper.NewDated(day(1), 1),
+		helper.NewDated(day(2), 2),
+		helper.NewDated(day(3), 3),
+		helper.NewDated(day(4), 4),
+	}
+
+	expected := []helper.Dated[int]{
+		helper.NewDated(day(2), 3),
+		helper.NewDated(day(3), 5),
+		helper.NewDated(day(4), 7),
+	}
+
+	actual := helper.ChanToSlice(helper.ComputeDatedIndicatorWithContext[int](ctx, datedSum{}, helper.SliceToChan(input)))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestComputeDatedIndicatorDeprecated(t *testing.T) {
+	var indicator helper.DatedIndicator[int] = datedSum{}
+
+	input := []helper.Dated[int]{
+		helper.NewDated(day(1), 1),
+		helper.NewDated(day(2), 2),
+	}
+
+	expected := []helper.Dated[int]{
+		helper.NewDated(day(2), 3),
+	}
+
+	actual := helper.ChanToSlice(helper.ComputeDatedIndicatorWithContext[int](context.Background(), indicator, helper.SliceToChan(input)))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestJoinDated(t *testing.T) {
+	ctx := context.Background()
+
+	a := []helper.Dated[int]{
+		helper.NewDated(day(1), 1),
+		helper.NewDated(day(2), 2),
+		helper.NewDated(day(4), 4),
+		helper.NewDated(day(5), 5),
+	}
+
+	b := []helper.Dated[int]{
+		helper.NewDated(day(2), 20),
+		helper.NewDated(day(3), 30),
+		helper.NewDated(day(4), 40),
+		helper.NewDated(day(6), 60),
+	}
+
+	c := []helper.Dated[int]{
+		helper.NewDated(day(1), 100),
+		helper.NewDated(day(2), 200),
+		helper.NewDated(day(4), 400),
+	}
+
+	expected := []helper.Dated[[]int]{
+		helper.NewDated(day(2), []int{2, 20, 200}),
+		helper.NewDated(day(4), []int{4, 40, 400}),
+	}
+
+	actual := helper.ChanToSlice(helper.JoinDatedWithContext(ctx,
+		helper.SliceToChan(a),
+		helper.SliceToChan(b),
+		helper.SliceToChan(c),
+	))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestJoinDatedNoInputs(t *testing.T) {
+	actual := helper.ChanToSlice(helper.JoinDatedWithContext[int](context.Background()))
+	if len(actual) != 0 {
+		t.Fatalf("actual %v expected empty", actual)
+	}
+}
+
+func TestAlignDated(t *testing.T) {
+	ctx := context.Background()
+
+	a := []helper.Dated[int]{
+		helper.NewDated(day(1), 1),
+		helper.NewDated(day(2), 2),
+		helper.NewDated(day(3), 3),
+	}
+
+	b := []helper.Dated[int]{
+		helper.NewDated(day(2), 20),
+		helper.NewDated(day(3), 30),
+	}
+
+	aligned := helper.AlignDatedWithContext(ctx, helper.SliceToChan(a), helper.SliceToChan(b))
+
+	err := helper.CheckEquals(
+		aligned[0], helper.SliceToChan(a[1:]),
+		aligned[1], helper.SliceToChan(b),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// datedDelay is a simple indicator for testing that skips the first ten values.
+type datedDelay struct{}
+
+func (datedDelay) ComputeWithContext(ctx context.Context, c <-chan int) <-chan int {
+	return helper.SkipWithContext(ctx, c, 10)
+}
+
+func (datedDelay) IdlePeriod() int {
+	return 10
+}
+
+func TestJoinDatedDuplicated(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	values := make([]helper.Dated[int], 20)
+	for i := range values {
+		values[i] = helper.NewDated(day(i+1), i)
+	}
+
+	splice := helper.DuplicateWithContext(ctx, helper.SliceToChan(values), 2)
+	delayed := helper.ComputeDatedIndicatorWithContext[int](ctx, datedDelay{}, splice[1])
+
+	actual := helper.ChanToSlice(helper.JoinDatedWithContext(ctx, splice[0], delayed))
+
+	if ctx.Err() != nil {
+		t.Fatal(ctx.Err())
+	}
+
+	if len(actual) != 10 {
+		t.Fatalf("actual %v expected 10 values", len(actual))
+	}
+
+	for i, joined := range actual {
+		if !joined.Date.Equal(day(i+11)) || joined.Value[0] != i+10 || joined.Value[1] != i+10 {
+			t.Fatalf("actual %v at %d", joined, i)
+		}
+	}
+}
+
+func TestJoinDatedDrainsInputs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	values := make([]helper.Dated[int], 20)
+	for i := range values {
+		values[i] = helper.NewDated(day(i+1), i)
+	}
+
+	// The short series ends the join early, while the long series is still being produced.
+	short := helper.SliceToChan(values[:2])
+	long := make(chan helper.Dated[int])
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer close(long)
+
+		for _, value := range values {
+			select {
+			case <-ctx.Done():
+				return
+			case long <- value:
+			}
+		}
+	}()
+
+	helper.ChanToSlice(helper.JoinDatedWithContext(ctx, short, long))
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("long series is not drained")
+	}
+}
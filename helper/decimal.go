@@ -0,0 +1,424 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DecimalPlaces is the number of fractional digits kept by the Decimal type.
+	DecimalPlaces = 8
+
+	// decimalScale is the number of units in one.
+	decimalScale = 100_000_000
+)
+
+var (
+	// ErrDecimalSyntax indicates that the given string is not a valid decimal.
+	ErrDecimalSyntax = errors.New("invalid decimal syntax")
+
+	// ErrDecimalRange indicates that the given value, such as NaN, is not
+	// representable as a decimal.
+	ErrDecimalRange = errors.New("value not representable as a decimal")
+)
+
+// Decimal is a signed fixed-point decimal number with DecimalPlaces fractional
+// digits, stored as an integer number of units. Unlike float64, the decimal
+// fractions such as 0.1 are represented exactly, and the additions and the
+// subtractions never drift. The multiplications and the divisions are rounded
+// half away from zero to the nearest unit.
+//
+// The range of the Decimal is about ±92 billion. The operations panic on
+// division by zero and, unlike the integer types that silently wrap around,
+// on overflow.
+//
+// Example:
+//
+//	price := helper.MustParseDecimal("10.10")
+//	total := price.Mul(helper.NewDecimalFromInt(3)) // 30.3
+type Decimal struct {
+	units int64
+}
+
+// NewDecimalFromInt function initializes a new decimal from the given integer.
+func NewDecimalFromInt(n int64) Decimal {
+	return Decimal{units: mulDivRound(n, decimalScale, 1)}
+}
+
+// NewDecimalFromFloat function initializes a new decimal from the given float
+// value, rounding it to the nearest unit. The value must be finite and within
+// the range of the Decimal, otherwise it panics.
+func NewDecimalFromFloat(f float64) Decimal {
+	d, err := DecimalFromFloat(f)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// DecimalFromFloat function converts the given float value to a decimal,
+// rounding it to the nearest unit. It returns an error if the value is not
+// finite or not within the range of the Decimal.
+func DecimalFromFloat(f float64) (Decimal, error) {
+	d, err := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	if err != nil {
+		return Decimal{}, fmt.Errorf("%w: %v", ErrDecimalRange, f)
+	}
+
+	return d, nil
+}
+
+// ParseDecimal function parses the given string, such as "-12.345", as a
+// decimal. The fractional digits beyond DecimalPlaces are rounded half away
+// from zero.
+func ParseDecimal(s string) (Decimal, error) {
+	text := strings.TrimSpace(s)
+
+	negative := false
+	if text != "" && (text[0] == '-' || text[0] == '+') {
+		negative = text[0] == '-'
+		text = text[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(text, ".")
+	if integer == "" && fraction == "" {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrDecimalSyntax, s)
+	}
+
+	for _, digits := range []string{integer, fraction} {
+		for _, r := range digits {
+			if r < '0' || r > '9' {
+				return Decimal{}, fmt.Errorf("%w: %q", ErrDecimalSyntax, s)
+			}
+		}
+	}
+
+	roundUp := false
+	if len(fraction) > DecimalPlaces {
+		roundUp = fraction[DecimalPlaces] >= '5'
+		fraction = fraction[:DecimalPlaces]
+	}
+
+	fraction += strings.Repeat("0", DecimalPlaces-len(fraction))
+
+	units, err := strconv.ParseInt(integer+fraction, 10, 64)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("%w: %q is out of range", ErrDecimalSyntax, s)
+	}
+
+	if roundUp {
+		if units == math.MaxInt64 {
+			return Decimal{}, fmt.Errorf("%w: %q is out of range", ErrDecimalSyntax, s)
+		}
+
+		units++
+	}
+
+	if negative {
+		units = -units
+	}
+
+	return Decimal{units: units}, nil
+}
+
+// MustParseDecimal function parses the given string as a decimal, and panics
+// if the string is not a valid decimal.
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// Add returns the sum of d and o.
+func (d Decimal) Add(o Decimal) Decimal {
+	units := d.units + o.units
+	if (d.units < 0) == (o.units < 0) && (units < 0) != (d.units < 0) {
+		panic("decimal: overflow")
+	}
+
+	return Decimal{units: units}
+}
+
+// Sub returns the difference of d and o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	return d.Add(o.Neg())
+}
+
+// Mul returns the product of d and o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{units: mulDivRound(d.units, o.units, decimalScale)}
+}
+
+// Div returns the quotient of d and o.
+func (d Decimal) Div(o Decimal) Decimal {
+	return Decimal{units: mulDivRound(d.units, decimalScale, o.units)}
+}
+
+// MulDiv returns d * m / n. It is rounded only once, and the intermediate
+// product may exceed the range of the Decimal, so it is more precise than
+// the Mul followed by the Div.
+func (d Decimal) MulDiv(m, n Decimal) Decimal {
+	return Decimal{units: mulDivRound(d.units, m.units, n.units)}
+}
+
+// Neg returns the negation of d.
+func (d Decimal) Neg() Decimal {
+	if d.units == math.MinInt64 {
+		panic("decimal: overflow")
+	}
+
+	return Decimal{units: -d.units}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	if d.units < 0 {
+		return d.Neg()
+	}
+
+	return d
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	switch {
+	case d.units < 0:
+		return -1
+	case d.units > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsZero returns true if d is zero.
+func (d Decimal) IsZero() bool {
+	return d.units == 0
+}
+
+// Cmp compares d and o, and returns -1 if d is less than o, 0 if they are
+// equal, and 1 if d is greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	switch {
+	case d.units < o.units:
+		return -1
+	case d.units > o.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Pow returns d raised to the power of the given integer exponent.
+func (d Decimal) Pow(n int) Decimal {
+	if n < 0 {
+		return NewDecimalFromInt(1).Div(d.Pow(-n))
+	}
+
+	result := NewDecimalFromInt(1)
+	for base := d; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.Mul(base)
+		}
+
+		if n > 1 {
+			base = base.Mul(base)
+		}
+	}
+
+	return result
+}
+
+// Sqrt returns the square root of d, truncated to the unit. It panics if d
+// is negative.
+func (d Decimal) Sqrt() Decimal {
+	if d.units < 0 {
+		panic("decimal: square root of negative number")
+	}
+
+	n := new(big.Int).Mul(big.NewInt(d.units), big.NewInt(decimalScale))
+	return Decimal{units: n.Sqrt(n).Int64()}
+}
+
+// Float64 returns the nearest float value of d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns the string representation of d without the trailing zeros.
+func (d Decimal) String() string {
+	units := d.units
+
+	sign := ""
+	if units < 0 {
+		sign = "-"
+	}
+
+	magnitude := uint64(units)
+	if units < 0 {
+		magnitude = uint64(-units)
+	}
+
+	integer := magnitude / decimalScale
+	fraction := magnitude % decimalScale
+
+	if fraction == 0 {
+		return fmt.Sprintf("%s%d", sign, integer)
+	}
+
+	digits := strings.TrimRight(fmt.Sprintf("%0*d", DecimalPlaces, fraction), "0")
+	return fmt.Sprintf("%s%d.%s", sign, integer, digits)
+}
+
+// MarshalText returns the text representation of d.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the given text representation into d.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+	return nil
+}
+
+// DecimalsFromFloatsWithContext converts the given float values to decimals,
+// supporting context cancellation. Since the decimals have no value for NaN
+// and infinity, the conversion stops at the first value that is not
+// representable as a decimal, logging the error with the default logger.
+func DecimalsFromFloatsWithContext[T Number](ctx context.Context, c <-chan T) <-chan Decimal {
+	result := make(chan Decimal)
+
+	go func() {
+		// The remaining values are drained after an error, so that the producer is not blocked.
+		defer DrainWithContext(ctx, c)
+		defer close(result)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case n, ok := <-c:
+				if !ok {
+					return
+				}
+
+				d, err := DecimalFromFloat(float64(n))
+				if err != nil {
+					slog.Default().Error("Unable to convert value.", "error", err)
+					return
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case result <- d:
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// DecimalsToFloatsWithContext converts the given decimals to float values,
+// supporting context cancellation.
+func DecimalsToFloatsWithContext(ctx context.Context, c <-chan Decimal) <-chan float64 {
+	return MapWithContext(ctx, c, Decimal.Float64)
+}
+
+// AddDecimalsWithContext adds each pair of decimals from the two input channels,
+// supporting context cancellation.
+func AddDecimalsWithContext(ctx context.Context, ac, bc <-chan Decimal) <-chan Decimal {
+	return OperateWithContext(ctx, ac, bc, Decimal.Add)
+}
+
+// SubtractDecimalsWithContext subtracts each decimal in the second channel from
+// the corresponding decimal in the first channel, supporting context cancellation.
+func SubtractDecimalsWithContext(ctx context.Context, ac, bc <-chan Decimal) <-chan Decimal {
+	return OperateWithContext(ctx, ac, bc, Decimal.Sub)
+}
+
+// MultiplyDecimalsWithContext multiplies each pair of decimals from the two input
+// channels, supporting context cancellation.
+func MultiplyDecimalsWithContext(ctx context.Context, ac, bc <-chan Decimal) <-chan Decimal {
+	return OperateWithContext(ctx, ac, bc, Decimal.Mul)
+}
+
+// DivideDecimalsWithContext divides each decimal in the first channel by the
+// corresponding decimal in the second channel, supporting context cancellation.
+func DivideDecimalsWithContext(ctx context.Context, ac, bc <-chan Decimal) <-chan Decimal {
+	return OperateWithContext(ctx, ac, bc, Decimal.Div)
+}
+
+// SqrtDecimalsWithContext calculates the square root of each decimal in the
+// channel, supporting context cancellation.
+func SqrtDecimalsWithContext(ctx context.Context, c <-chan Decimal) <-chan Decimal {
+	return MapWithContext(ctx, c, Decimal.Sqrt)
+}
+
+// mulDivRound returns a * b / c rounded half away from zero, using a 128-bit
+// intermediate product so that only the final result needs to fit in int64.
+func mulDivRound(a, b, c int64) int64 {
+	if c == 0 {
+		panic("decimal: division by zero")
+	}
+
+	negative := (a < 0) != (b < 0) != (c < 0)
+
+	hi, lo := bits.Mul64(absUint64(a), absUint64(b))
+	divisor := absUint64(c)
+
+	if hi >= divisor {
+		panic("decimal: overflow")
+	}
+
+	quotient, remainder := bits.Div64(hi, lo, divisor)
+	if remainder >= divisor-remainder {
+		quotient++
+	}
+
+	if negative {
+		if quotient > 1<<63 {
+			panic("decimal: overflow")
+		}
+
+		return int64(-quotient)
+	}
+
+	if quotient > math.MaxInt64 {
+		panic("decimal: overflow")
+	}
+
+	return int64(quotient)
+}
+
+// absUint64 returns the absolute value of the given integer as an unsigned integer.
+func absUint64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-n)
+	}
+
+	return uint64(n)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestDecimalParse(t *testing.T) {
+	tests := map[string]string{
+		"0":            "0",
+		"1.50":         "1.5",
+		"-0.25":        "-0.25",
+		"+3":           "3",
+		".5":           "0.5",
+		"7.":           "7",
+		"0.123456785":  "0.12345679",
+		"-0.123456785": "-0.12345679",
+		" 42.1 ":       "42.1",
+	}
+
+	for input, expected := range tests {
+		actual, err := helper.ParseDecimal(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual.String() != expected {
+			t.Fatalf("actual %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestDecimalParseInvalid(t *testing.T) {
+	for _, input := range []string{"", "-", ".", "1.2.3", "abc", "1e5", "999999999999"} {
+		_, err := helper.ParseDecimal(input)
+		if !errors.Is(err, helper.ErrDecimalSyntax) {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := helper.MustParseDecimal("0.1")
+	b := helper.MustParseDecimal("0.2")
+
+	if a.Add(b) != helper.MustParseDecimal("0.3") {
+		t.Fatalf("actual %v expected 0.3", a.Add(b))
+	}
+
+	if a.Sub(b) != helper.MustParseDecimal("-0.1") {
+		t.Fatalf("actual %v expected -0.1", a.Sub(b))
+	}
+
+	if a.Mul(b) != helper.MustParseDecimal("0.02") {
+		t.Fatalf("actual %v expected 0.02", a.Mul(b))
+	}
+
+	if a.Div(b) != helper.MustParseDecimal("0.5") {
+		t.Fatalf("actual %v expected 0.5", a.Div(b))
+	}
+
+	third := helper.NewDecimalFromInt(-2).Div(helper.NewDecimalFromInt(3))
+	if third != helper.MustParseDecimal("-0.66666667") {
+		t.Fatalf("actual %v expected -0.66666667", third)
+	}
+
+	if helper.NewDecimalFromInt(2).Sqrt() != helper.MustParseDecimal("1.41421356") {
+		t.Fatalf("actual %v expected 1.41421356", helper.NewDecimalFromInt(2).Sqrt())
+	}
+
+	if helper.MustParseDecimal("1.1").Pow(2) != helper.MustParseDecimal("1.21") {
+		t.Fatalf("actual %v expected 1.21", helper.MustParseDecimal("1.1").Pow(2))
+	}
+
+	if helper.NewDecimalFromInt(2).Pow(-1) != helper.MustParseDecimal("0.5") {
+		t.Fatalf("actual %v expected 0.5", helper.NewDecimalFromInt(2).Pow(-1))
+	}
+
+	price := helper.MustParseDecimal("612345.67")
+	if helper.NewDecimalFromInt(1).MulDiv(helper.NewDecimalFromInt(650000), price) != helper.MustParseDecimal("1.06149195") {
+		t.Fatalf("actual %v expected 1.06149195", helper.NewDecimalFromInt(1).MulDiv(helper.NewDecimalFromInt(650000), price))
+	}
+
+	if a.Cmp(b) != -1 || b.Cmp(a) != 1 || a.Cmp(a) != 0 {
+		t.Fatal("wrong comparison")
+	}
+
+	if a.Neg().Abs() != a || a.Neg().Sign() != -1 || !a.Sub(a).IsZero() {
+		t.Fatal("wrong sign")
+	}
+}
+
+func TestDecimalNoDrift(t *testing.T) {
+	sum := helper.Decimal{}
+	for i := 0; i < 1000000; i++ {
+		sum = sum.Add(helper.MustParseDecimal("0.1"))
+	}
+
+	if sum != helper.NewDecimalFromInt(100000) {
+		t.Fatalf("actual %v expected 100000", sum)
+	}
+}
+
+func TestDecimalFloat(t *testing.T) {
+	d := helper.NewDecimalFromFloat(12.34)
+	if d.String() != "12.34" {
+		t.Fatalf("actual %v expected 12.34", d)
+	}
+
+	if d.Float64() != 12.34 {
+		t.Fatalf("actual %v expected 12.34", d.Float64())
+	}
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), 1e12} {
+		_, err := helper.DecimalFromFloat(f)
+		if !errors.Is(err, helper.ErrDecimalRange) {
+			t.Fatalf("actual %v expected %v", err, helper.ErrDecimalRange)
+		}
+	}
+}
+
+func TestDecimalPanics(t *testing.T) {
+	checkPanic := func(f func()) {
+		t.Helper()
+
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected panic")
+			}
+		}()
+
+		f()
+	}
+
+	checkPanic(func() { helper.NewDecimalFromInt(1).Div(helper.Decimal{}) })
+	checkPanic(func() { helper.NewDecimalFromInt(-1).Sqrt() })
+	checkPanic(func() { helper.NewDecimalFromInt(1 << 40).Mul(helper.NewDecimalFromInt(1 << 40)) })
+	checkPanic(func() { helper.MustParseDecimal("x") })
+	checkPanic(func() { helper.NewDecimalFromFloat(math.NaN()) })
+
+	smallest := helper.MustParseDecimal("-92233720368.54775807").Sub(helper.MustParseDecimal("0.00000001"))
+	checkPanic(func() { smallest.Neg() })
+	checkPanic(func() { smallest.Abs() })
+	checkPanic(func() { helper.Decimal{}.Sub(smallest) })
+}
+
+func TestDecimalJSON(t *testing.T) {
+	type Row struct {
+		Price helper.Decimal
+	}
+
+	data, err := json.Marshal(Row{Price: helper.MustParseDecimal("1.25")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"Price":"1.25"}` {
+		t.Fatalf("actual %s", data)
+	}
+
+	var row Row
+	err = json.Unmarshal(data, &row)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if row.Price != helper.MustParseDecimal("1.25") {
+		t.Fatalf("actual %v expected 1.25", row.Price)
+	}
+}
+
+func TestDecimalChannels(t *testing.T) {
+	ctx := context.Background()
+
+	a := helper.DecimalsFromFloatsWithContext(ctx, helper.SliceToChan([]float64{1, 4, 9}))
+	b := helper.DecimalsFromFloatsWithContext(ctx, helper.SliceToChan([]float64{1, 2, 3}))
+
+	splice := helper.DuplicateWithContext(ctx, a, 4)
+	other := helper.DuplicateWithContext(ctx, b, 4)
+
+	sums := helper.DecimalsToFloatsWithContext(ctx, helper.AddDecimalsWithContext(ctx, splice[0], other[0]))
+	differences := helper.DecimalsToFloatsWithContext(ctx, helper.SubtractDecimalsWithContext(ctx, splice[1], other[1]))
+	products := helper.DecimalsToFloatsWithContext(ctx, helper.MultiplyDecimalsWithContext(ctx, splice[2], other[2]))
+	quotients := helper.DecimalsToFloatsWithContext(ctx, helper.DivideDecimalsWithContext(ctx, splice[3], other[3]))
+
+	err := helper.CheckEquals(
+		sums, helper.SliceToChan([]float64{2, 6, 12}),
+		differences, helper.SliceToChan([]float64{0, 2, 6}),
+		products, helper.SliceToChan([]float64{1, 8, 27}),
+		quotients, helper.SliceToChan([]float64{1, 2, 3}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := helper.DecimalsToFloatsWithContext(ctx, helper.SqrtDecimalsWithContext(ctx,
+		helper.DecimalsFromFloatsWithContext(ctx, helper.SliceToChan([]float64{1, 4, 9}))))
+
+	err = helper.CheckEquals(roots, helper.SliceToChan([]float64{1, 2, 3}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalsFromFloatsStopsAtNaN(t *testing.T) {
+	decimals := helper.DecimalsFromFloatsWithContext(context.Background(),
+		helper.SliceToChan([]float64{1, 2, math.NaN(), 4}),
+	)
+
+	err := helper.CheckEquals(decimals, helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimalFromInt(1),
+		helper.NewDecimalFromInt(2),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// DuplicateChunksWithContext duplicates the given channel of chunks on the
+// requested number of new output channels, supporting context cancellation.
+// It is the chunked counterpart of DuplicateWithContext. The chunks are not
+// copied, but shared between the outputs, so they must not be modified.
+func DuplicateChunksWithContext[T any](ctx context.Context, input <-chan []T, count int) []<-chan []T {
+	return DuplicateWithContext(ctx, input, count)
+}
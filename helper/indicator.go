@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// Indicator defines the shared interface of the single input and single
+// output indicators, such as the moving averages.
+type Indicator[T any] interface {
+	// ComputeWithContext computes the indicator over the given values.
+	ComputeWithContext(ctx context.Context, c <-chan T) <-chan T
+
+	// IdlePeriod is the initial period that the indicator won't yield any results.
+	IdlePeriod() int
+}
+
+// ChunkedIndicator defines the interface of the indicators that can also
+// compute over chunks of values, passing a batch of values with each channel
+// send to reduce the goroutine handoffs for the long series.
+type ChunkedIndicator[T any] interface {
+	Indicator[T]
+
+	// ComputeChunksWithContext computes the indicator over the given chunks of values.
+	ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T
+}
+
+// ComputeChunksWithContext computes the given indicator over the given chunks
+// of values, using its chunked implementation if it is a ChunkedIndicator, or
+// otherwise computing over the unchunked values and chunking the results with
+// the DefaultChunkSize.
+func ComputeChunksWithContext[T any](ctx context.Context, indicator Indicator[T], c <-chan []T) <-chan []T {
+	if chunked, ok := indicator.(ChunkedIndicator[T]); ok {
+		return chunked.ComputeChunksWithContext(ctx, c)
+	}
+
+	return ChunkWithContext(ctx, indicator.ComputeWithContext(ctx, UnchunkWithContext(ctx, c)), DefaultChunkSize)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// MapChunksWithContext applies the given transformation function to each value
+// in the chunks of the input channel and returns a new channel containing the
+// chunks of the transformed values, supporting context cancellation. It is the
+// chunked counterpart of MapWithContext.
+func MapChunksWithContext[F, T any](ctx context.Context, c <-chan []F, f func(F) T) <-chan []T {
+	return FilterMapChunksWithContext(ctx, c, func(n F) (T, bool) {
+		return f(n), true
+	})
+}
+
+// FilterMapChunksWithContext applies the given function to each value in the
+// chunks of the input channel in order, and returns a new channel containing
+// the chunks of the results for which the function returns true, supporting
+// context cancellation. The function may keep state between the calls, which
+// makes it suitable for the stateful indicators, such as the moving windows
+// that do not yield results until they are full.
+func FilterMapChunksWithContext[F, T any](ctx context.Context, c <-chan []F, f func(F) (T, bool)) <-chan []T {
+	result := make(chan []T)
+
+	go func() {
+		defer close(result)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case chunk, ok := <-c:
+				if !ok {
+					return
+				}
+
+				mapped := make([]T, 0, len(chunk))
+
+				for _, n := range chunk {
+					if m, ok := f(n); ok {
+						mapped = append(mapped, m)
+					}
+				}
+
+				if !sendChunkWithContext(ctx, result, mapped) {
+					return
+				}
+			}
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+)
+
+// NDJSONToChanWithContext reads values from the specified reader in newline delimited JSON
+// (NDJSON) format, one value per line, into a channel of values, supporting context cancellation.
+// The values are streamed as they are read, so the reader can be a pipe such as the standard input.
+//
+// Example:
+//
+//	snapshots := helper.NDJSONToChanWithContext[asset.Snapshot](ctx, os.Stdin)
+func NDJSONToChanWithContext[T any](ctx context.Context, r io.Reader) <-chan T {
+	return NDJSONToChanWithLoggerWithContext[T](ctx, r, slog.Default())
+}
+
+// NDJSONToChanWithLoggerWithContext reads values from the specified reader in newline delimited JSON
+// (NDJSON) format into a channel of values with logger and context.
+func NDJSONToChanWithLoggerWithContext[T any](ctx context.Context, r io.Reader, logger *slog.Logger) <-chan T {
+	c := make(chan T)
+
+	go func() {
+		defer close(c)
+
+		decoder := json.NewDecoder(r)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			var value T
+
+			err := decoder.Decode(&value)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				logger.Error("Unable to decode value.", "error", err)
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case c <- value:
+			}
+		}
+	}()
+
+	return c
+}
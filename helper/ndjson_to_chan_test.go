@@ -0,0 +1,76 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestNDJSONToChan(t *testing.T) {
+	input := strings.NewReader("2\n4\n\n6\n8\n")
+	expected := helper.SliceToChan([]int{2, 4, 6, 8})
+
+	actual := helper.NDJSONToChanWithContext[int](context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNDJSONToChanStreaming(t *testing.T) {
+	reader, writer := io.Pipe()
+	defer reader.Close()
+
+	actual := helper.NDJSONToChanWithContext[int](context.Background(), reader)
+
+	// Each value is received before the next one is written.
+	for i := 1; i <= 3; i++ {
+		_, err := writer.Write([]byte(strings.Repeat("1", i) + "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		value := <-actual
+		if value != []int{1, 11, 111}[i-1] {
+			t.Fatalf("actual %v", value)
+		}
+	}
+
+	writer.Close()
+
+	if _, ok := <-actual; ok {
+		t.Fatal("expected closed")
+	}
+}
+
+func TestNDJSONToChanInvalid(t *testing.T) {
+	input := strings.NewReader("1\nabc\n3\n")
+	expected := helper.SliceToChan([]int{1})
+
+	actual := helper.NDJSONToChanWithContext[int](context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNDJSONToChanCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reader, writer := io.Pipe()
+	defer writer.Close()
+
+	actual := helper.NDJSONToChanWithContext[int](ctx, reader)
+
+	helper.Drain(actual)
+}
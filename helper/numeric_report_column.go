@@ -40,3 +40,8 @@ func (*numericReportColumn[T]) Role() string {
 func (c *numericReportColumn[T]) Value() string {
 	return fmt.Sprintf("%v", <-c.values)
 }
+
+// cell returns the next typed value for the report column.
+func (c *numericReportColumn[T]) cell() reportCell {
+	return reportCell{numbers: []float64{float64(<-c.values)}}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// OperateChunksWithContext applies the provided operate function to the
+// corresponding values from the chunks of the two input channels, and sends
+// the chunks of the results to an output channel, supporting context
+// cancellation. The chunks of the two channels do not need to be aligned. It
+// is the chunked counterpart of OperateWithContext.
+func OperateChunksWithContext[A any, B any, R any](ctx context.Context, ac <-chan []A, bc <-chan []B, o func(A, B) R) <-chan []R {
+	result := make(chan []R)
+
+	go func() {
+		defer close(result)
+
+		var as []A
+		var bs []B
+
+		for {
+			var ok bool
+
+			for len(as) == 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case as, ok = <-ac:
+					if !ok {
+						DrainWithContext(ctx, bc)
+						return
+					}
+				}
+			}
+
+			for len(bs) == 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case bs, ok = <-bc:
+					if !ok {
+						DrainWithContext(ctx, ac)
+						return
+					}
+				}
+			}
+
+			n := min(len(as), len(bs))
+			operated := make([]R, n)
+
+			for i := range operated {
+				operated[i] = o(as[i], bs[i])
+			}
+
+			as = as[n:]
+			bs = bs[n:]
+
+			if !sendChunkWithContext(ctx, result, operated) {
+				return
+			}
+		}
+	}()
+
+	return result
+}
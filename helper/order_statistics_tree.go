@@ -0,0 +1,509 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"math"
+)
+
+// orderStatisticsNode represents the order statistics tree node. The equal
+// values share a single node along with their count.
+type orderStatisticsNode[T Number] struct {
+	value  T
+	count  int
+	size   int
+	height int
+	left   *orderStatisticsNode[T]
+	right  *orderStatisticsNode[T]
+}
+
+// OrderStatisticsTree represents a balanced (AVL) binary search tree that
+// keeps the number of values under each node, so that in addition to the
+// insertions and the removals, the rank and the k-th smallest value queries
+// are answered in O(log n) time.
+//
+// The NaN values are ordered before all other values.
+//
+// Example:
+//
+//	tree := helper.NewOrderStatisticsTree[float64]()
+//	tree.Insert(3)
+//	tree.Insert(1)
+//	tree.Insert(2)
+//
+//	fmt.Println(tree.Median()) // 2
+type OrderStatisticsTree[T Number] struct {
+	root *orderStatisticsNode[T]
+}
+
+// NewOrderStatisticsTree creates a new order statistics tree.
+func NewOrderStatisticsTree[T Number]() *OrderStatisticsTree[T] {
+	return &OrderStatisticsTree[T]{}
+}
+
+// Len returns the number of values in the tree.
+func (o *OrderStatisticsTree[T]) Len() int {
+	return o.root.getSize()
+}
+
+// Insert adds a new value to the tree.
+func (o *OrderStatisticsTree[T]) Insert(value T) {
+	o.root = o.root.insert(value)
+}
+
+// Remove removes one occurrence of the given value from the tree. It returns
+// false if the value is not found.
+func (o *OrderStatisticsTree[T]) Remove(value T) bool {
+	var removed bool
+	o.root, removed = o.root.remove(value)
+	return removed
+}
+
+// Contains checks whether the given value exists in the tree.
+func (o *OrderStatisticsTree[T]) Contains(value T) bool {
+	node := o.root
+
+	for node != nil {
+		switch compareOrdered(value, node.value) {
+		case 0:
+			return true
+		case -1:
+			node = node.left
+		default:
+			node = node.right
+		}
+	}
+
+	return false
+}
+
+// Min returns the minimum value in the tree, or zero if the tree is empty.
+func (o *OrderStatisticsTree[T]) Min() T {
+	return o.Select(0)
+}
+
+// Max returns the maximum value in the tree, or zero if the tree is empty.
+func (o *OrderStatisticsTree[T]) Max() T {
+	return o.Select(o.Len() - 1)
+}
+
+// Select returns the k-th smallest value in the tree, starting from zero. It
+// returns zero if k is out of range.
+func (o *OrderStatisticsTree[T]) Select(k int) T {
+	if k < 0 || k >= o.Len() {
+		return T(0)
+	}
+
+	node := o.root
+
+	for {
+		leftSize := node.left.getSize()
+
+		switch {
+		case k < leftSize:
+			node = node.left
+
+		case k < leftSize+node.count:
+			return node.value
+
+		default:
+			k -= leftSize + node.count
+			node = node.right
+		}
+	}
+}
+
+// Rank returns the number of values in the tree that are less than the given value.
+func (o *OrderStatisticsTree[T]) Rank(value T) int {
+	rank := 0
+	node := o.root
+
+	for node != nil {
+		switch compareOrdered(value, node.value) {
+		case -1:
+			node = node.left
+
+		case 0:
+			return rank + node.left.getSize()
+
+		default:
+			rank += node.left.getSize() + node.count
+			node = node.right
+		}
+	}
+
+	return rank
+}
+
+// CountLessOrEqual returns the number of values in the tree that are less than
+// or equal to the given value.
+func (o *OrderStatisticsTree[T]) CountLessOrEqual(value T) int {
+	count := 0
+	node := o.root
+
+	for node != nil {
+		if compareOrdered(value, node.value) < 0 {
+			node = node.left
+		} else {
+			count += node.left.getSize() + node.count
+			node = node.right
+		}
+	}
+
+	return count
+}
+
+// Quantile returns the given quantile, between 0 and 1, of the values in the
+// tree, linearly interpolating between the closest ranks. It returns zero if
+// the tree is empty.
+func (o *OrderStatisticsTree[T]) Quantile(q float64) T {
+	n := o.Len()
+	if n == 0 {
+		return T(0)
+	}
+
+	q = math.Max(0, math.Min(1, q))
+
+	position := q * float64(n-1)
+	lower := int(math.Floor(position))
+	fraction := position - float64(lower)
+
+	value := o.Select(lower)
+	if fraction == 0 {
+		return value
+	}
+
+	upper := o.Select(lower + 1)
+
+	return T(float64(value) + (float64(upper)-float64(value))*fraction)
+}
+
+// Median returns the median of the values in the tree. It returns zero if the
+// tree is empty.
+func (o *OrderStatisticsTree[T]) Median() T {
+	return o.Quantile(0.5)
+}
+
+// MedianAbsoluteDeviation returns the median of the absolute deviations of the
+// values in the tree from their median. The deviations of the values below and
+// above the median form two sorted sequences, which are merged by a binary
+// search, so the result is found in O(log² n) time without visiting all values.
+func (o *OrderStatisticsTree[T]) MedianAbsoluteDeviation() T {
+	n := o.Len()
+	if n == 0 {
+		return T(0)
+	}
+
+	median := o.Median()
+	split := o.Rank(median)
+
+	// below returns the i-th smallest deviation of the values below the median.
+	below := func(i int) float64 {
+		return float64(median) - float64(o.Select(split-1-i))
+	}
+
+	// above returns the i-th smallest deviation of the other values.
+	above := func(i int) float64 {
+		return float64(o.Select(split+i)) - float64(median)
+	}
+
+	// kth returns the k-th smallest deviation, starting from zero.
+	kth := func(k int) float64 {
+		low := max(0, k+1-(n-split))
+		high := min(k+1, split)
+
+		for low < high {
+			i := (low + high) / 2
+			if below(i) < above(k-i) {
+				low = i + 1
+			} else {
+				high = i
+			}
+		}
+
+		deviation := math.Inf(-1)
+		if low > 0 {
+			deviation = below(low - 1)
+		}
+
+		if k+1-low > 0 {
+			deviation = math.Max(deviation, above(k-low))
+		}
+
+		return deviation
+	}
+
+	if n%2 == 1 {
+		return T(kth(n / 2))
+	}
+
+	return T((kth(n/2-1) + kth(n/2)) / 2)
+}
+
+// compareOrdered compares the given values, ordering NaN before all other values.
+func compareOrdered[T Number](a, b T) int {
+	aNaN := a != a
+	bNaN := b != b
+
+	switch {
+	case aNaN || bNaN:
+		if aNaN && bNaN {
+			return 0
+		}
+
+		if aNaN {
+			return -1
+		}
+
+		return 1
+
+	case a < b:
+		return -1
+
+	case a > b:
+		return 1
+
+	default:
+		return 0
+	}
+}
+
+// getSize returns the number of values under the node.
+func (n *orderStatisticsNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// getHeight returns the height of the node.
+func (n *orderStatisticsNode[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+// update updates the size and the height of the node from its children.
+func (n *orderStatisticsNode[T]) update() {
+	n.size = n.left.getSize() + n.count + n.right.getSize()
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+}
+
+// rotateLeft rotates the node to the left and returns the new subtree root.
+func (n *orderStatisticsNode[T]) rotateLeft() *orderStatisticsNode[T] {
+	root := n.right
+	n.right = root.left
+	root.left = n
+
+	n.update()
+	root.update()
+
+	return root
+}
+
+// rotateRight rotates the node to the right and returns the new subtree root.
+func (n *orderStatisticsNode[T]) rotateRight() *orderStatisticsNode[T] {
+	root := n.left
+	n.left = root.right
+	root.right = n
+
+	n.update()
+	root.update()
+
+	return root
+}
+
+// rebalance updates the node and restores the AVL balance of its subtree,
+// returning the new subtree root.
+func (n *orderStatisticsNode[T]) rebalance() *orderStatisticsNode[T] {
+	n.update()
+
+	balance := n.left.getHeight() - n.right.getHeight()
+
+	if balance > 1 {
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	}
+
+	if balance < -1 {
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+// insert adds the value to the subtree and returns the new subtree root.
+func (n *orderStatisticsNode[T]) insert(value T) *orderStatisticsNode[T] {
+	if n == nil {
+		return &orderStatisticsNode[T]{
+			value:  value,
+			count:  1,
+			size:   1,
+			height: 1,
+		}
+	}
+
+	switch compareOrdered(value, n.value) {
+	case -1:
+		n.left = n.left.insert(value)
+
+	case 1:
+		n.right = n.right.insert(value)
+
+	default:
+		n.count++
+	}
+
+	return n.rebalance()
+}
+
+// remove removes one occurrence of the value from the subtree and returns the
+// new subtree root along with whether the value was found.
+func (n *orderStatisticsNode[T]) remove(value T) (*orderStatisticsNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var removed bool
+
+	switch compareOrdered(value, n.value) {
+	case -1:
+		n.left, removed = n.left.remove(value)
+
+	case 1:
+		n.right, removed = n.right.remove(value)
+
+	default:
+		removed = true
+
+		if n.count > 1 {
+			n.count--
+			break
+		}
+
+		if n.left == nil {
+			return n.right, true
+		}
+
+		if n.right == nil {
+			return n.left, true
+		}
+
+		// Replace the node with its successor.
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		n.value = successor.value
+		n.count = successor.count
+		n.right = n.right.removeMin()
+	}
+
+	return n.rebalance(), removed
+}
+
+// removeMin removes the node with the minimum value from the subtree and
+// returns the new subtree root.
+func (n *orderStatisticsNode[T]) removeMin() *orderStatisticsNode[T] {
+	if n.left == nil {
+		return n.right
+	}
+
+	n.left = n.left.removeMin()
+
+	return n.rebalance()
+}
+
+// MovingOrderStatisticsWithContext keeps the last period values of the given
+// channel in an order statistics tree, and calls the given function with the
+// tree and the newest value once the window is full, supporting context
+// cancellation. Each value is inserted and removed in O(log n) time.
+//
+// Example:
+//
+//	medians := helper.MovingOrderStatisticsWithContext(ctx, c, 10, func(tree *helper.OrderStatisticsTree[float64], _ float64) float64 {
+//		return tree.Median()
+//	})
+func MovingOrderStatisticsWithContext[T Number, R any](ctx context.Context, c <-chan T, period int, f func(*OrderStatisticsTree[T], T) R) <-chan R {
+	step := movingOrderStatisticsStep(period, f)
+
+	results := make(chan R)
+
+	go func() {
+		defer close(results)
+
+		for {
+			var value T
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case value, ok = <-c:
+			}
+
+			if !ok {
+				return
+			}
+
+			result, ok := step(value)
+			if !ok {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case results <- result:
+			}
+		}
+	}()
+
+	return results
+}
+
+// MovingOrderStatisticsChunksWithContext is the chunked counterpart of
+// MovingOrderStatisticsWithContext, taking and returning chunks of values.
+func MovingOrderStatisticsChunksWithContext[T Number, R any](ctx context.Context, c <-chan []T, period int, f func(*OrderStatisticsTree[T], T) R) <-chan []R {
+	return FilterMapChunksWithContext(ctx, c, movingOrderStatisticsStep(period, f))
+}
+
+// movingOrderStatisticsStep returns a function that adds the given value to
+// the moving window, and returns the result of the given function once the
+// window is full.
+func movingOrderStatisticsStep[T Number, R any](period int, f func(*OrderStatisticsTree[T], T) R) func(T) (R, bool) {
+	tree := NewOrderStatisticsTree[T]()
+	window := make([]T, period)
+	next := 0
+
+	return func(value T) (R, bool) {
+		if tree.Len() == period {
+			tree.Remove(window[next])
+		}
+
+		tree.Insert(value)
+		window[next] = value
+		next = (next + 1) % period
+
+		if tree.Len() < period {
+			var zero R
+			return zero, false
+		}
+
+		return f(tree, value), true
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// bruteForceQuantile returns the linearly interpolated quantile of the given sorted values.
+func bruteForceQuantile(sorted []float64, q float64) float64 {
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	fraction := position - float64(lower)
+
+	if fraction == 0 {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (sorted[lower+1]-sorted[lower])*fraction
+}
+
+// bruteForceMad returns the median absolute deviation of the given values.
+func bruteForceMad(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	median := bruteForceQuantile(sorted, 0.5)
+
+	deviations := make([]float64, len(values))
+	for i, value := range values {
+		deviations[i] = math.Abs(value - median)
+	}
+
+	slices.Sort(deviations)
+
+	return bruteForceQuantile(deviations, 0.5)
+}
+
+func TestOrderStatisticsTree(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	tree := helper.NewOrderStatisticsTree[float64]()
+	var values []float64
+
+	for i := 0; i < 2000; i++ {
+		if len(values) > 0 && random.Intn(3) == 0 {
+			index := random.Intn(len(values))
+			if !tree.Remove(values[index]) {
+				t.Fatalf("unable to remove %v", values[index])
+			}
+
+			values = slices.Delete(values, index, index+1)
+		} else {
+			value := float64(random.Intn(50))
+			tree.Insert(value)
+			values = append(values, value)
+		}
+
+		if tree.Len() != len(values) {
+			t.Fatalf("actual %v expected %v", tree.Len(), len(values))
+		}
+
+		if len(values) == 0 {
+			continue
+		}
+
+		sorted := slices.Clone(values)
+		slices.Sort(sorted)
+
+		k := random.Intn(len(sorted))
+		if tree.Select(k) != sorted[k] {
+			t.Fatalf("select %d actual %v expected %v", k, tree.Select(k), sorted[k])
+		}
+
+		probe := float64(random.Intn(52) - 1)
+		rank, _ := slices.BinarySearch(sorted, probe)
+		if tree.Rank(probe) != rank {
+			t.Fatalf("rank %v actual %v expected %v", probe, tree.Rank(probe), rank)
+		}
+
+		lessOrEqual, _ := slices.BinarySearch(sorted, probe+0.5)
+		if tree.CountLessOrEqual(probe) != lessOrEqual {
+			t.Fatalf("count %v actual %v expected %v", probe, tree.CountLessOrEqual(probe), lessOrEqual)
+		}
+
+		if tree.Min() != sorted[0] || tree.Max() != sorted[len(sorted)-1] {
+			t.Fatal("wrong min or max")
+		}
+
+		q := random.Float64()
+		if math.Abs(tree.Quantile(q)-bruteForceQuantile(sorted, q)) > 1e-9 {
+			t.Fatalf("quantile %v actual %v expected %v", q, tree.Quantile(q), bruteForceQuantile(sorted, q))
+		}
+
+		if math.Abs(tree.MedianAbsoluteDeviation()-bruteForceMad(values)) > 1e-9 {
+			t.Fatalf("mad actual %v expected %v", tree.MedianAbsoluteDeviation(), bruteForceMad(values))
+		}
+	}
+}
+
+func TestOrderStatisticsTreeEmpty(t *testing.T) {
+	tree := helper.NewOrderStatisticsTree[int]()
+
+	if tree.Len() != 0 || tree.Min() != 0 || tree.Max() != 0 || tree.Median() != 0 || tree.MedianAbsoluteDeviation() != 0 {
+		t.Fatal("expected zero values")
+	}
+
+	if tree.Remove(1) || tree.Contains(1) {
+		t.Fatal("expected not found")
+	}
+
+	tree.Insert(1)
+	tree.Insert(1)
+
+	if !tree.Contains(1) || tree.Len() != 2 {
+		t.Fatal("expected duplicate values")
+	}
+}
+
+func TestOrderStatisticsTreeNaN(t *testing.T) {
+	tree := helper.NewOrderStatisticsTree[float64]()
+	tree.Insert(2)
+	tree.Insert(math.NaN())
+	tree.Insert(1)
+
+	if !math.IsNaN(tree.Min()) || tree.Select(1) != 1 || tree.Max() != 2 {
+		t.Fatal("expected NaN first")
+	}
+
+	if !tree.Remove(math.NaN()) || tree.Len() != 2 {
+		t.Fatal("unable to remove NaN")
+	}
+}
+
+func TestMovingOrderStatistics(t *testing.T) {
+	input := helper.SliceToChan([]int{5, 1, 4, 2, 3, 0})
+	expected := helper.SliceToChan([]int{4, 2, 3, 2})
+
+	actual := helper.MovingOrderStatisticsWithContext(context.Background(), input, 3, func(tree *helper.OrderStatisticsTree[int], _ int) int {
+		return tree.Median()
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMovingOrderStatisticsChunks(t *testing.T) {
+	ctx := context.Background()
+
+	input := helper.SliceToChunks([]int{5, 1, 4, 2, 3, 0}, 2)
+	expected := helper.SliceToChan([]int{4, 2, 3, 2})
+
+	actual := helper.UnchunkWithContext(ctx, helper.MovingOrderStatisticsChunksWithContext(ctx, input, 3, func(tree *helper.OrderStatisticsTree[int], _ int) int {
+		return tree.Median()
+	}))
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
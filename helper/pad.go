@@ -0,0 +1,134 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// Padded wraps an indicator so that it emits exactly one value per input.
+// The warm-up positions of the indicator are filled with the fill value,
+// so that the outputs of different indicators can be zipped directly.
+//
+// Example:
+//
+//	padded := helper.NewPadded[float64](trend.NewEmaWithPeriod[float64](10))
+//	result := padded.ComputeWithContext(ctx, closings)
+type Padded[T Number] struct {
+	// Indicator is the wrapped indicator.
+	Indicator Indicator[T]
+
+	// Fill is the value emitted for the warm-up positions.
+	Fill T
+}
+
+// NewPadded function initializes a new padded indicator instance that fills
+// the warm-up positions with NaN.
+func NewPadded[T Float](indicator Indicator[T]) *Padded[T] {
+	return NewPaddedWithFill(indicator, T(math.NaN()))
+}
+
+// NewPaddedWithFill function initializes a new padded indicator instance that
+// fills the warm-up positions with the given sentinel value.
+func NewPaddedWithFill[T Number](indicator Indicator[T], fill T) *Padded[T] {
+	return &Padded[T]{
+		Indicator: indicator,
+		Fill:      fill,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the wrapped
+// indicator, emitting the fill value for its warm-up positions.
+func (p *Padded[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return ComputePaddedWithContext(ctx, c, p.Indicator.IdlePeriod(), p.Fill, func(values <-chan T) <-chan T {
+		return p.Indicator.ComputeWithContext(ctx, values)
+	})
+}
+
+// IdlePeriod is the initial period that the padded indicator won't yield any
+// results, which is always zero.
+func (*Padded[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the padded indicator.
+func (p *Padded[T]) String() string {
+	return fmt.Sprintf("Padded(%v)", p.Indicator)
+}
+
+// PadWithContext emits exactly one value for each value of the reference
+// channel. The fill value is emitted for the first idle period values, and
+// the values of the given channel afterwards. When the given channel ends
+// early, the fill value is emitted for the rest, supporting context
+// cancellation.
+// This is useful to pad each output of the multiple output indicators.
+//
+// Example:
+//
+//	closings := helper.Duplicate(c, 3)
+//	macds, signals := macd.ComputeWithContext(ctx, closings[0])
+//	macds = helper.PadWithContext(ctx, closings[1], macds, macd.IdlePeriod(), math.NaN())
+//	signals = helper.PadWithContext(ctx, closings[2], signals, macd.IdlePeriod(), math.NaN())
+func PadWithContext[F, T any](ctx context.Context, reference <-chan F, c <-chan T, idlePeriod int, fill T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		// Drain the remaining values, so that the computation is not blocked.
+		defer func() {
+			go DrainWithContext(ctx, reference)
+			go DrainWithContext(ctx, c)
+		}()
+
+		done := false
+
+		for i := 0; ; i++ {
+			select {
+			case <-ctx.Done():
+				return
+
+			case _, ok := <-reference:
+				if !ok {
+					return
+				}
+			}
+
+			value := fill
+
+			if i >= idlePeriod && !done {
+				select {
+				case <-ctx.Done():
+					return
+
+				case n, ok := <-c:
+					if ok {
+						value = n
+					} else {
+						done = true
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case result <- value:
+			}
+		}
+	}()
+
+	return result
+}
+
+// ComputePaddedWithContext runs the given function with the given idle period
+// over the values, and emits exactly one value per input by emitting the fill
+// value for the warm-up positions, supporting context cancellation.
+func ComputePaddedWithContext[T, R any](ctx context.Context, c <-chan T, idlePeriod int, fill R, f func(<-chan T) <-chan R) <-chan R {
+	inputs := DuplicateWithContext(ctx, c, 2)
+	return PadWithContext(ctx, inputs[1], f(inputs[0]), idlePeriod, fill)
+}
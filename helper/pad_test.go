@@ -0,0 +1,106 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// padSum is a simple indicator for testing that sums the last three values.
+type padSum[T helper.Number] struct{}
+
+func (padSum[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	sums := helper.WindowWithContext(ctx, c, func(w []T, _ int) T {
+		var sum T
+		for _, n := range w {
+			sum += n
+		}
+
+		return sum
+	}, 3)
+
+	return helper.SkipWithContext(ctx, sums, 2)
+}
+
+func (padSum[T]) IdlePeriod() int {
+	return 2
+}
+
+func (padSum[T]) String() string {
+	return "Sum(3)"
+}
+
+func TestPadded(t *testing.T) {
+	input := helper.SliceToChan([]float64{1, 2, 3, 4, 5})
+
+	padded := helper.NewPadded[float64](padSum[float64]{})
+	actual := helper.ChanToSlice(padded.ComputeWithContext(context.Background(), input))
+
+	if len(actual) != 5 {
+		t.Fatalf("actual %v expected 5 values", actual)
+	}
+
+	if !math.IsNaN(actual[0]) || !math.IsNaN(actual[1]) {
+		t.Fatalf("actual %v expected NaN", actual[:2])
+	}
+
+	expected := []float64{6, 9, 12}
+	for i, value := range expected {
+		if actual[i+2] != value {
+			t.Fatalf("actual %v expected %v", actual[i+2], value)
+		}
+	}
+
+	if padded.IdlePeriod() != 0 {
+		t.Fatalf("actual %v expected 0", padded.IdlePeriod())
+	}
+
+	if padded.String() != "Padded(Sum(3))" {
+		t.Fatalf("actual %v", padded.String())
+	}
+}
+
+func TestPaddedWithFill(t *testing.T) {
+	input := helper.SliceToChan([]int{1, 2, 3, 4})
+	expected := helper.SliceToChan([]int{-1, -1, 6, 9})
+
+	padded := helper.NewPaddedWithFill[int](padSum[int]{}, -1)
+	actual := padded.ComputeWithContext(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPaddedShortInput(t *testing.T) {
+	input := helper.SliceToChan([]int{1})
+	expected := helper.SliceToChan([]int{0})
+
+	padded := helper.NewPaddedWithFill[int](padSum[int]{}, 0)
+	actual := padded.ComputeWithContext(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPadEndsEarly(t *testing.T) {
+	reference := helper.SliceToChan([]int{1, 2, 3, 4})
+	values := helper.SliceToChan([]int{10})
+	expected := helper.SliceToChan([]int{0, 10, 0, 0})
+
+	actual := helper.PadWithContext(context.Background(), reference, values, 1, 0)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -17,58 +17,23 @@ func PercentRank[T Number](c <-chan T, period int) <-chan T {
 }
 
 // PercentRankWithContext returns a channel that emits the percentile rank
-// of each value compared to the previous period-1 values, supporting context cancellation.
+// of each value compared to the previous period-1 values, supporting context
+// cancellation. The values are kept in an order statistics tree, so each
+// update takes O(log n) time. The first rank is emitted after period values,
+// similar to the SortedPercentRankWithContext.
 func PercentRankWithContext[T Number](ctx context.Context, c <-chan T, period int) <-chan T {
-	r := make(chan T)
-
 	if period <= 1 {
+		r := make(chan T)
 		close(r)
 		return r
 	}
 
-	go func() {
-		defer close(r)
-
-		values := make([]T, 0, period)
-		count := 0
+	// The current value is in the window, but it is never less than itself.
+	ranks := MovingOrderStatisticsWithContext(ctx, c, period, func(tree *OrderStatisticsTree[T], value T) T {
+		return T(float64(tree.Rank(value)) * 100.0 / float64(period-1))
+	})
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case value, ok := <-c:
-				if !ok {
-					return
-				}
-				if count < period {
-					values = append(values, value)
-					count++
-					continue
-				}
-
-				// Shift: remove oldest, add new
-				copy(values[0:period-1], values[1:period])
-				values[period-1] = value
-
-				// Count how many values are less than current
-				lessCount := 0
-				for i := 0; i < period-1; i++ {
-					if values[i] < value {
-						lessCount++
-					}
-				}
-
-				rank := float64(lessCount) * 100.0 / float64(period-1)
-				select {
-				case <-ctx.Done():
-					return
-				case r <- T(rank):
-				}
-			}
-		}
-	}()
-
-	return r
+	return SkipWithContext(ctx, ranks, 1)
 }
 
 // SortedPercentRank wraps SortedPercentRankWithContext for backwards compatibility.
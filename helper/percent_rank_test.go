@@ -73,3 +73,18 @@ func TestSortedPercentRankPeriodOne(t *testing.T) {
 		t.Fatalf("expected empty, got %v", res)
 	}
 }
+
+func TestPercentRankMatchesSortedPercentRank(t *testing.T) {
+	values := make([]float64, 200)
+	for i := range values {
+		values[i] = float64((i * 37) % 23)
+	}
+
+	actual := helper.PercentRank(helper.SliceToChan(values), 20)
+	expected := helper.SortedPercentRank(helper.SliceToChan(values), 20)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
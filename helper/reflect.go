@@ -67,9 +67,39 @@ func setReflectValueFromFloat(value reflect.Value, stringValue string, bitSize i
 	return err
 }
 
-// setReflectValueFromTime assigns the parsed unsigned float value to the specified variable.
-func setReflectValueFromTime(value reflect.Value, stringValue, format string) error {
-	actualValue, err := time.Parse(format, stringValue)
+// setReflectValueFromTime assigns the parsed time value to the specified variable. The time is
+// parsed in the given location, or in UTC if the location is nil.
+func setReflectValueFromTime(value reflect.Value, stringValue, format string, location *time.Location) error {
+	var actualValue time.Time
+	var err error
+
+	switch format {
+	case UnixDateTimeFormat, UnixMilliDateTimeFormat:
+		var epoch int64
+
+		epoch, err = strconv.ParseInt(stringValue, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		if format == UnixDateTimeFormat {
+			actualValue = time.Unix(epoch, 0).UTC()
+		} else {
+			actualValue = time.UnixMilli(epoch).UTC()
+		}
+
+		if location != nil {
+			actualValue = actualValue.In(location)
+		}
+
+	default:
+		if location != nil {
+			actualValue, err = time.ParseInLocation(format, stringValue, location)
+		} else {
+			actualValue, err = time.Parse(format, stringValue)
+		}
+	}
+
 	if err == nil {
 		value.Set(reflect.ValueOf(actualValue))
 	}
@@ -77,8 +107,33 @@ func setReflectValueFromTime(value reflect.Value, stringValue, format string) er
 	return err
 }
 
+// getReflectValueFromTime returns the string representation of the given time value. The time is
+// converted to the given location first if the location is not nil.
+func getReflectValueFromTime(actualValue time.Time, format string, location *time.Location) string {
+	switch format {
+	case UnixDateTimeFormat:
+		return strconv.FormatInt(actualValue.Unix(), 10)
+
+	case UnixMilliDateTimeFormat:
+		return strconv.FormatInt(actualValue.UnixMilli(), 10)
+
+	default:
+		if location != nil {
+			actualValue = actualValue.In(location)
+		}
+
+		return actualValue.Format(format)
+	}
+}
+
 // setReflectValue assigns the parsed value to the specified variable.
 func setReflectValue(value reflect.Value, stringValue, format string) error {
+	return setReflectValueInLocation(value, stringValue, format, nil)
+}
+
+// setReflectValueInLocation assigns the parsed value to the specified variable, parsing the time
+// values in the given location.
+func setReflectValueInLocation(value reflect.Value, stringValue, format string, location *time.Location) error {
 	kind := value.Kind()
 
 	switch kind {
@@ -103,7 +158,7 @@ func setReflectValue(value reflect.Value, stringValue, format string) error {
 
 		switch typeString {
 		case "time.Time":
-			return setReflectValueFromTime(value, stringValue, format)
+			return setReflectValueFromTime(value, stringValue, format, location)
 
 		default:
 			return fmt.Errorf("unsupported struct type %s", typeString)
@@ -116,6 +171,12 @@ func setReflectValue(value reflect.Value, stringValue, format string) error {
 
 // getReflectValue returns the string representation of the given value.
 func getReflectValue(value reflect.Value, format string) (string, error) {
+	return getReflectValueInLocation(value, format, nil)
+}
+
+// getReflectValueInLocation returns the string representation of the given value, formatting the
+// time values in the given location.
+func getReflectValueInLocation(value reflect.Value, format string, location *time.Location) (string, error) {
 	kind := value.Kind()
 
 	switch kind {
@@ -139,7 +200,7 @@ func getReflectValue(value reflect.Value, format string) (string, error) {
 
 		switch typeString {
 		case "time.Time":
-			return value.Interface().(time.Time).Format(format), nil
+			return getReflectValueFromTime(value.Interface().(time.Time), format, location), nil
 
 		default:
 			return "", fmt.Errorf("unsupported struct type %s", typeString)
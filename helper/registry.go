@@ -0,0 +1,278 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+const (
+	// InputOpening is the input name for the opening prices.
+	InputOpening = "opening"
+
+	// InputHigh is the input name for the high prices.
+	InputHigh = "high"
+
+	// InputLow is the input name for the low prices.
+	InputLow = "low"
+
+	// InputClosing is the input name for the closing prices.
+	InputClosing = "closing"
+
+	// InputVolume is the input name for the volumes.
+	InputVolume = "volume"
+
+	// MaxPeriod is the maximum value of the period parameters, bounding the
+	// memory used by the indicators that keep their periods in memory.
+	MaxPeriod = 10000
+)
+
+// IndicatorComputeFunc defines a function to compute an indicator over the given
+// inputs, in the order of the descriptor inputs, and return its outputs, in the
+// order of the descriptor outputs.
+type IndicatorComputeFunc func(ctx context.Context, inputs []<-chan float64) []<-chan float64
+
+// IndicatorBuilderFunc defines a function to build an indicator using the given
+// parameters. It returns the compute function and the idle period of the indicator.
+type IndicatorBuilderFunc func(params map[string]float64) (IndicatorComputeFunc, int)
+
+// IndicatorParameter describes a parameter of an indicator.
+type IndicatorParameter struct {
+	// Name is the name of the parameter.
+	Name string
+
+	// Description is the description of the parameter.
+	Description string
+
+	// Default is the default value of the parameter.
+	Default float64
+
+	// Min is the minimum value of the parameter.
+	Min float64
+
+	// Max is the maximum value of the parameter.
+	Max float64
+
+	// Integer indicates whether the parameter only accepts integer values.
+	Integer bool
+}
+
+// IndicatorDescriptor describes an indicator, its parameters, inputs and outputs,
+// along with the builder to build and compute it.
+type IndicatorDescriptor struct {
+	// Name is the unique name of the indicator, such as "ema".
+	Name string
+
+	// Title is the human readable name of the indicator.
+	Title string
+
+	// Category is the category of the indicator, such as "trend".
+	Category string
+
+	// Parameters is the parameter schema of the indicator.
+	Parameters []IndicatorParameter
+
+	// Inputs is the names of the inputs of the indicator.
+	Inputs []string
+
+	// Outputs is the names of the outputs of the indicator.
+	Outputs []string
+
+	// Builder builds the indicator using the given parameters.
+	Builder IndicatorBuilderFunc
+}
+
+// NewPeriodParameter function initializes a new integer period parameter with the
+// given name and default value.
+func NewPeriodParameter(name string, defaultPeriod int) IndicatorParameter {
+	return NewPeriodParameterWithMin(name, defaultPeriod, 1)
+}
+
+// NewPeriodParameterWithMin function initializes a new integer period parameter
+// with the given name, default value, and minimum value, for the indicators that
+// need more than one value in their periods.
+func NewPeriodParameterWithMin(name string, defaultPeriod, minPeriod int) IndicatorParameter {
+	return IndicatorParameter{
+		Name:        name,
+		Description: "Time period.",
+		Default:     float64(defaultPeriod),
+		Min:         float64(minPeriod),
+		Max:         MaxPeriod,
+		Integer:     true,
+	}
+}
+
+// NewIndicatorBuilder function returns a builder for the single input and single
+// output indicators using the given function to build the indicator.
+func NewIndicatorBuilder(build func(params map[string]float64) Indicator[float64]) IndicatorBuilderFunc {
+	return func(params map[string]float64) (IndicatorComputeFunc, int) {
+		indicator := build(params)
+
+		return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+			return []<-chan float64{
+				indicator.ComputeWithContext(ctx, inputs[0]),
+			}
+		}, indicator.IdlePeriod()
+	}
+}
+
+// indicatorDescriptorsMu guards indicatorDescriptors against concurrent registration and lookup.
+var indicatorDescriptorsMu sync.RWMutex
+
+// indicatorDescriptors provides mapping for the indicator descriptors.
+var indicatorDescriptors = map[string]*IndicatorDescriptor{}
+
+// RegisterIndicator registers the given indicator descriptor.
+func RegisterIndicator(descriptor *IndicatorDescriptor) {
+	indicatorDescriptorsMu.Lock()
+	defer indicatorDescriptorsMu.Unlock()
+
+	indicatorDescriptors[descriptor.Name] = descriptor
+}
+
+// IndicatorDescriptors returns all registered indicator descriptors ordered by
+// their categories and names.
+func IndicatorDescriptors() []*IndicatorDescriptor {
+	indicatorDescriptorsMu.RLock()
+	defer indicatorDescriptorsMu.RUnlock()
+
+	descriptors := make([]*IndicatorDescriptor, 0, len(indicatorDescriptors))
+	for _, descriptor := range indicatorDescriptors {
+		descriptors = append(descriptors, descriptor)
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool {
+		if descriptors[i].Category != descriptors[j].Category {
+			return descriptors[i].Category < descriptors[j].Category
+		}
+
+		return descriptors[i].Name < descriptors[j].Name
+	})
+
+	return descriptors
+}
+
+// GetIndicatorDescriptor returns the indicator descriptor by the given name.
+func GetIndicatorDescriptor(name string) (*IndicatorDescriptor, error) {
+	indicatorDescriptorsMu.RLock()
+	descriptor, ok := indicatorDescriptors[name]
+	indicatorDescriptorsMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown indicator: %s", name)
+	}
+
+	return descriptor, nil
+}
+
+// ComputeIndicatorWithContext builds the indicator by the given name using the given
+// parameters, and computes it over the given inputs keyed by their names. It returns
+// the outputs keyed by their names.
+//
+// Example:
+//
+//	outputs, err := helper.ComputeIndicatorWithContext(ctx, "ema", map[string]float64{"period": 10},
+//		map[string]<-chan float64{helper.InputClosing: closings},
+//	)
+func ComputeIndicatorWithContext(ctx context.Context, name string, params map[string]float64, inputs map[string]<-chan float64) (map[string]<-chan float64, error) {
+	descriptor, err := GetIndicatorDescriptor(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return descriptor.ComputeWithContext(ctx, params, inputs)
+}
+
+// Defaults returns the default values of the parameters.
+func (d *IndicatorDescriptor) Defaults() map[string]float64 {
+	params := make(map[string]float64, len(d.Parameters))
+	for _, parameter := range d.Parameters {
+		params[parameter.Name] = parameter.Default
+	}
+
+	return params
+}
+
+// Resolve validates the given parameters against the parameter schema, and
+// returns them along with the default values for the missing ones.
+func (d *IndicatorDescriptor) Resolve(params map[string]float64) (map[string]float64, error) {
+	resolved := d.Defaults()
+
+	for name, value := range params {
+		parameter, ok := d.parameter(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter %s for %s", name, d.Name)
+		}
+
+		if math.IsNaN(value) || value < parameter.Min || value > parameter.Max {
+			return nil, fmt.Errorf("parameter %s for %s must be between %v and %v", name, d.Name, parameter.Min, parameter.Max)
+		}
+
+		if parameter.Integer && value != math.Trunc(value) {
+			return nil, fmt.Errorf("parameter %s for %s must be an integer", name, d.Name)
+		}
+
+		resolved[name] = value
+	}
+
+	return resolved, nil
+}
+
+// IdlePeriod returns the idle period of the indicator with the given parameters.
+func (d *IndicatorDescriptor) IdlePeriod(params map[string]float64) (int, error) {
+	resolved, err := d.Resolve(params)
+	if err != nil {
+		return 0, err
+	}
+
+	_, idlePeriod := d.Builder(resolved)
+
+	return idlePeriod, nil
+}
+
+// ComputeWithContext builds the indicator using the given parameters, and computes
+// it over the given inputs keyed by their names. It returns the outputs keyed by
+// their names. All outputs start after the idle period of the indicator.
+func (d *IndicatorDescriptor) ComputeWithContext(ctx context.Context, params map[string]float64, inputs map[string]<-chan float64) (map[string]<-chan float64, error) {
+	resolved, err := d.Resolve(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := make([]<-chan float64, len(d.Inputs))
+	for i, name := range d.Inputs {
+		input, ok := inputs[name]
+		if !ok {
+			return nil, fmt.Errorf("missing input %s for %s", name, d.Name)
+		}
+
+		ordered[i] = input
+	}
+
+	compute, _ := d.Builder(resolved)
+	results := compute(ctx, ordered)
+
+	outputs := make(map[string]<-chan float64, len(d.Outputs))
+	for i, name := range d.Outputs {
+		outputs[name] = results[i]
+	}
+
+	return outputs, nil
+}
+
+// parameter returns the parameter by the given name.
+func (d *IndicatorDescriptor) parameter(name string) (IndicatorParameter, bool) {
+	for _, parameter := range d.Parameters {
+		if parameter.Name == name {
+			return parameter, true
+		}
+	}
+
+	return IndicatorParameter{}, false
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	_ "github.com/cinar/indicator/v2/momentum"
+	_ "github.com/cinar/indicator/v2/trend"
+	_ "github.com/cinar/indicator/v2/volatility"
+	_ "github.com/cinar/indicator/v2/volume"
+)
+
+func init() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "test_multiply",
+		Title:    "Test Multiply",
+		Category: "test",
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("skip", 1),
+			{
+				Name:    "factor",
+				Default: 2,
+				Min:     0,
+				Max:     10,
+			},
+		},
+		Inputs:  []string{helper.InputClosing},
+		Outputs: []string{"result"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			skip := int(params["skip"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{
+					helper.MultiplyByWithContext(ctx, helper.SkipWithContext(ctx, inputs[0], skip), params["factor"]),
+				}
+			}, skip
+		},
+	})
+}
+
+func TestComputeIndicator(t *testing.T) {
+	inputs := map[string]<-chan float64{
+		helper.InputClosing: helper.SliceToChan([]float64{1, 2, 3, 4}),
+	}
+
+	outputs, err := helper.ComputeIndicatorWithContext(context.Background(), "test_multiply",
+		map[string]float64{"factor": 3}, inputs,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(outputs["result"], helper.SliceToChan([]float64{6, 9, 12}))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestComputeIndicatorUnknown(t *testing.T) {
+	_, err := helper.ComputeIndicatorWithContext(context.Background(), "unknown", nil, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestComputeIndicatorMissingInput(t *testing.T) {
+	_, err := helper.ComputeIndicatorWithContext(context.Background(), "test_multiply", nil, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestIndicatorDescriptorResolve(t *testing.T) {
+	descriptor, err := helper.GetIndicatorDescriptor("test_multiply")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolved, err := descriptor.Resolve(map[string]float64{"skip": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resolved["skip"] != 3 || resolved["factor"] != 2 {
+		t.Fatalf("actual %v", resolved)
+	}
+
+	invalids := []map[string]float64{
+		{"unknown": 1},
+		{"factor": 11},
+		{"factor": math.NaN()},
+		{"skip": 0},
+		{"skip": 1.5},
+		{"skip": helper.MaxPeriod + 1},
+	}
+
+	for _, invalid := range invalids {
+		_, err := descriptor.Resolve(invalid)
+		if err == nil {
+			t.Fatalf("expected error for %v", invalid)
+		}
+
+		_, err = descriptor.IdlePeriod(invalid)
+		if err == nil {
+			t.Fatalf("expected error for %v", invalid)
+		}
+
+		_, err = descriptor.ComputeWithContext(context.Background(), invalid, nil)
+		if err == nil {
+			t.Fatalf("expected error for %v", invalid)
+		}
+	}
+
+	idlePeriod, err := descriptor.IdlePeriod(map[string]float64{"skip": 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if idlePeriod != 4 {
+		t.Fatalf("actual %v expected 4", idlePeriod)
+	}
+}
+
+func TestRegisteredIndicatorMinPeriod(t *testing.T) {
+	descriptor, err := helper.GetIndicatorDescriptor("connors_rsi")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = descriptor.Resolve(map[string]float64{"percentRankPeriod": 1})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestIndicatorDescriptors(t *testing.T) {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{Name: "test_b", Category: "test"})
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{Name: "test_a", Category: "test"})
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{Name: "test_c", Category: "aaa"})
+
+	descriptors := helper.IndicatorDescriptors()
+
+	for i := 1; i < len(descriptors); i++ {
+		previous, current := descriptors[i-1], descriptors[i]
+
+		if previous.Category > current.Category ||
+			(previous.Category == current.Category && previous.Name > current.Name) {
+			t.Fatalf("not sorted %s %s", previous.Name, current.Name)
+		}
+	}
+}
+
+func TestRegisteredIndicators(t *testing.T) {
+	// The count is long enough for the indicators with the longest idle periods, such as Pring's Special K.
+	const count = 1000
+
+	tests := []struct {
+		category string
+		count    int
+	}{
+		{category: "momentum", count: 21},
+		{category: "trend", count: 55},
+		{category: "volatility", count: 21},
+		{category: "volume", count: 12},
+	}
+
+	registered := make(map[string]int)
+
+	for _, descriptor := range helper.IndicatorDescriptors() {
+		registered[descriptor.Category]++
+	}
+
+	for _, test := range tests {
+		if registered[test.category] != test.count {
+			t.Errorf("%s actual %v expected %v", test.category, registered[test.category], test.count)
+		}
+	}
+
+	for _, descriptor := range helper.IndicatorDescriptors() {
+		if descriptor.Builder == nil {
+			continue
+		}
+
+		t.Run(descriptor.Name, func(t *testing.T) {
+			idlePeriod, err := descriptor.IdlePeriod(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			inputs := make(map[string]<-chan float64)
+			for _, name := range descriptor.Inputs {
+				values := make([]float64, count)
+				for i := range values {
+					values[i] = 100 + 10*math.Sin(float64(i)/10) + float64(i%7)
+				}
+
+				inputs[name] = helper.SliceToChan(values)
+			}
+
+			outputs, err := descriptor.ComputeWithContext(context.Background(), nil, inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(outputs) != len(descriptor.Outputs) {
+				t.Fatalf("actual %v outputs expected %v", len(outputs), len(descriptor.Outputs))
+			}
+
+			counts := make(map[string]chan int)
+			for name, output := range outputs {
+				counts[name] = make(chan int, 1)
+
+				go func(output <-chan float64, result chan<- int) {
+					result <- len(helper.ChanToSlice(output))
+				}(output, counts[name])
+			}
+
+			for name, result := range counts {
+				actual := <-result
+				if actual != count-idlePeriod {
+					t.Errorf("%s actual %v expected %v", name, actual, count-idlePeriod)
+				}
+			}
+		})
+	}
+}
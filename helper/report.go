@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"text/template"
 	"time"
 )
@@ -17,9 +18,36 @@ import (
 //go:embed "report.tmpl"
 var reportTmpl string
 
+//go:embed "report_offline.tmpl"
+var reportOfflineTmpl string
+
+//go:embed "report.css"
+var reportCSS string
+
+//go:embed "report_dark.css"
+var reportDarkCSS string
+
 const (
 	// DefaultReportDateFormat is the default date format used in the report.
 	DefaultReportDateFormat = "2006-01-02"
+
+	// ReportStyleLine is the series style for the columns drawn as lines.
+	ReportStyleLine = "line"
+
+	// ReportStyleCandlesticks is the series style for the columns drawn as candlesticks.
+	ReportStyleCandlesticks = "candlesticks"
+
+	// ReportStyleBars is the series style for the columns drawn as bars.
+	ReportStyleBars = "bars"
+
+	// ReportStyleBand is the series style for the columns drawn as shaded bands.
+	ReportStyleBand = "band"
+
+	// ReportThemeLight is the default light report theme.
+	ReportThemeLight = "light"
+
+	// ReportThemeDark is the dark report theme.
+	ReportThemeDark = "dark"
 )
 
 // ReportColumn defines the interface that all report data columns must implement.
@@ -39,12 +67,42 @@ type ReportColumn interface {
 	Value() string
 }
 
+// StyledReportColumn defines the interface for the report data columns that
+// are not drawn as lines, such as the candlesticks, or that have more than one
+// value for each date, such as the bands. The columns that do not implement
+// this interface are drawn as lines with a single value.
+type StyledReportColumn interface {
+	ReportColumn
+
+	// Style returns the series style of the report column.
+	Style() string
+
+	// Cells returns the number of comma separated values returned by Value.
+	Cells() int
+}
+
 // Report generates an HTML file containing an interactive chart that
 // visually represents the provided data and annotations.
 //
 // The generated HTML file can be opened in a web browser to explore
 // the data visually, interact with the chart elements, and view
 // the associated annotations.
+//
+// By default, the report loads its charting library and stylesheet from the
+// web. When Offline is set, the charts are instead rendered in Go as inline
+// SVG images and the stylesheet is embedded, so that the report opens without
+// network access and renders the same way in the future.
+//
+// The look of the report can be changed through the Theme and the CSS. The
+// Template is parsed after the default template, so it can either replace the
+// whole report, or only redefine the "head", "top", and "bottom" blocks, which
+// are empty by default, to add sections such as parameter tables or notes.
+// The Funcs are made available to the templates.
+//
+// Example:
+//
+//	report.Theme = helper.ReportThemeDark
+//	report.Template = `{{ define "top" }}<p>Period: 20</p>{{ end }}`
 type Report struct {
 	Title       string
 	Date        <-chan time.Time
@@ -52,6 +110,11 @@ type Report struct {
 	Views       [][]int
 	DateFormat  string
 	GeneratedOn string
+	Offline     bool
+	Theme       string
+	CSS         string
+	Template    string
+	Funcs       template.FuncMap
 }
 
 // NewReport takes a channel of time as the time axis and returns a new
@@ -67,6 +130,7 @@ func NewReport(title string, date <-chan time.Time) *Report {
 		},
 		DateFormat:  DefaultReportDateFormat,
 		GeneratedOn: time.Now().String(),
+		Theme:       ReportThemeLight,
 	}
 }
 
@@ -95,29 +159,245 @@ func (r *Report) AddColumn(column ReportColumn, charts ...int) {
 
 // WriteToWriter writes the report content to the provided io.Writer.
 // This allows the report to be sent to various destinations, such
-// as a file, a network socket, or even the standard output.
-func (r *Report) WriteToWriter(writer io.Writer) error {
-	tmpl, err := template.New("report").Parse(reportTmpl)
-	if err != nil {
-		return err
+// as a file, a network socket, or even the standard output. The
+// report is written as HTML, unless another renderer is given.
+//
+// Example:
+//
+//	report.WriteToWriter(os.Stdout, helper.NewMarkdownReportRenderer())
+func (r *Report) WriteToWriter(writer io.Writer, renderer ...ReportRenderer) error {
+	if len(renderer) == 0 {
+		return NewHTMLReportRenderer().Render(r, writer)
 	}
 
-	return tmpl.Execute(writer, r)
+	return renderer[0].Render(r, writer)
 }
 
 // WriteToFile writes the generated report content to a file with
 // the specified name. This allows users to conveniently save the
-// report for later viewing or analysis.
-func (r *Report) WriteToFile(fileName string) error {
+// report for later viewing or analysis. The report is written as
+// HTML regardless of the file extension, unless another renderer is
+// given.
+//
+// Example:
+//
+//	report.WriteToFile("report.csv", helper.ReportRendererForFile("report.csv"))
+func (r *Report) WriteToFile(fileName string, renderer ...ReportRenderer) error {
+	if len(renderer) == 0 {
+		renderer = append(renderer, NewHTMLReportRenderer())
+	}
+
 	file, err := os.Create(filepath.Clean(fileName))
 	if err != nil {
 		return err
 	}
 
-	err = r.WriteToWriter(file)
+	err = r.WriteToWriter(file, renderer[0])
 	if err != nil {
+		CloseAndLogError(file, "unable to close report file")
 		return err
 	}
 
 	return file.Close()
 }
+
+// writeHTMLToWriter writes the report content as HTML to the provided io.Writer.
+func (r *Report) writeHTMLToWriter(writer io.Writer) error {
+	if r.Offline {
+		return r.writeOfflineToWriter(writer)
+	}
+
+	type Model struct {
+		*Report
+		Style       string
+		DataColumns []reportDataColumn
+		Charts      []reportChart
+	}
+
+	tmpl, err := ParseReportTemplate(reportTmpl, r.Template, r.Funcs)
+	if err != nil {
+		return err
+	}
+
+	model := Model{
+		Report:      r,
+		Style:       ReportStyle(false, r.Theme, r.CSS),
+		DataColumns: r.dataColumns(),
+		Charts:      r.charts(),
+	}
+
+	return tmpl.Execute(writer, model)
+}
+
+// ReportCSS returns the embedded stylesheet of the offline reports, so that
+// the other reports can share the same look without loading it from the web.
+func ReportCSS() string {
+	return reportCSS
+}
+
+// ReportStyle returns the embedded style rules for a report with the given
+// theme and custom CSS. The offline reports get the complete stylesheet,
+// while the other reports only get the theme and the custom rules that are
+// applied on top of the stylesheet loaded from the web.
+func ReportStyle(offline bool, theme, css string) string {
+	var sb strings.Builder
+
+	if offline {
+		sb.WriteString(reportCSS)
+	}
+
+	if theme == ReportThemeDark {
+		sb.WriteString(reportDarkCSS)
+	}
+
+	sb.WriteString(css)
+
+	return sb.String()
+}
+
+// ParseReportTemplate parses the given default report template, and then the
+// given custom template, if any, with the given functions. The custom template
+// can replace the default template, or only redefine its blocks.
+func ParseReportTemplate(defaultTemplate, customTemplate string, funcs template.FuncMap) (*template.Template, error) {
+	tmpl, err := template.New("report").Funcs(funcs).Parse(defaultTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if customTemplate != "" {
+		tmpl, err = tmpl.Parse(customTemplate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tmpl, nil
+}
+
+// writeOfflineToWriter renders the charts as inline SVG images and writes the
+// self-contained report content to the provided io.Writer.
+func (r *Report) writeOfflineToWriter(writer io.Writer) error {
+	type Model struct {
+		*Report
+		Style  string
+		Charts []string
+	}
+
+	tmpl, err := ParseReportTemplate(reportOfflineTmpl, r.Template, r.Funcs)
+	if err != nil {
+		return err
+	}
+
+	table := newReportTable(r)
+
+	model := Model{
+		Report: r,
+		Style:  ReportStyle(true, r.Theme, r.CSS),
+		Charts: make([]string, len(r.Views)),
+	}
+
+	for i, view := range r.Views {
+		height := reportSVGHeight
+		if i == 0 {
+			height = reportSVGMainHeight
+		}
+
+		model.Charts[i] = table.renderChart(r.Columns, view, height, r.DateFormat, r.Theme)
+	}
+
+	return tmpl.Execute(writer, model)
+}
+
+// reportDataColumn is a column of the interactive chart data table.
+type reportDataColumn struct {
+	Type  string
+	Label string
+	Role  string
+}
+
+// reportChart is an interactive chart along with its data table columns.
+type reportChart struct {
+	Type    string
+	Columns []int
+	Series  []string
+}
+
+// dataColumns returns the data table columns for the report columns, where
+// the styled columns span as many data table columns as their values.
+func (r *Report) dataColumns() []reportDataColumn {
+	var columns []reportDataColumn
+
+	for _, column := range r.Columns {
+		for i := 0; i < reportColumnCells(column); i++ {
+			columns = append(columns, reportDataColumn{
+				Type:  column.Type(),
+				Label: column.Name(),
+				Role:  column.Role(),
+			})
+		}
+	}
+
+	return columns
+}
+
+// charts returns the interactive charts for the report views, using a combo
+// chart when a view has a series that is not drawn as a line.
+func (r *Report) charts() []reportChart {
+	// The first data table column is the date.
+	offsets := make([]int, len(r.Columns))
+	offset := 1
+
+	for i, column := range r.Columns {
+		offsets[i] = offset
+		offset += reportColumnCells(column)
+	}
+
+	charts := make([]reportChart, len(r.Views))
+
+	for i, view := range r.Views {
+		chart := reportChart{
+			Type: "LineChart",
+		}
+
+		for _, columnID := range view {
+			column := r.Columns[columnID-1]
+
+			for cell := 0; cell < reportColumnCells(column); cell++ {
+				chart.Columns = append(chart.Columns, offsets[columnID-1]+cell)
+			}
+
+			if column.Role() != "data" {
+				continue
+			}
+
+			style := reportColumnStyle(column)
+			if style != ReportStyleLine {
+				chart.Type = "ComboChart"
+			}
+
+			chart.Series = append(chart.Series, style)
+		}
+
+		charts[i] = chart
+	}
+
+	return charts
+}
+
+// reportColumnStyle returns the series style of the given report column.
+func reportColumnStyle(column ReportColumn) string {
+	if styled, ok := column.(StyledReportColumn); ok {
+		return styled.Style()
+	}
+
+	return ReportStyleLine
+}
+
+// reportColumnCells returns the number of values of the given report column.
+func reportColumnCells(column ReportColumn) int {
+	if styled, ok := column.(StyledReportColumn); ok {
+		return styled.Cells()
+	}
+
+	return 1
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// csvReportRenderer renders the report data as CSV.
+type csvReportRenderer struct{}
+
+// NewCSVReportRenderer returns a new renderer writing the report dates and
+// column values as CSV, with a header row, for the spreadsheets.
+func NewCSVReportRenderer() ReportRenderer {
+	return csvReportRenderer{}
+}
+
+// Render writes the given report to the given writer.
+func (csvReportRenderer) Render(report *Report, writer io.Writer) error {
+	records := newReportRecords(report)
+
+	csvWriter := csv.NewWriter(writer)
+
+	err := csvWriter.Write(records.headers)
+	if err != nil {
+		return err
+	}
+
+	err = csvWriter.WriteAll(records.rows)
+	if err != nil {
+		return err
+	}
+
+	return csvWriter.Error()
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// jsonReportRenderer renders the report data as JSON.
+type jsonReportRenderer struct{}
+
+// NewJSONReportRenderer returns a new renderer writing the report dates and
+// column values as a JSON array of records, one object per date with the
+// fields in the column order, for the notebooks. The repeated column names are
+// numbered, such as "Close" and "Close 2", to keep the keys unique, and the
+// missing values, such as NaN, are written as null.
+func NewJSONReportRenderer() ReportRenderer {
+	return jsonReportRenderer{}
+}
+
+// Render writes the given report to the given writer.
+func (jsonReportRenderer) Render(report *Report, writer io.Writer) error {
+	records := newReportRecords(report)
+
+	headers := make([][]byte, len(records.headers))
+	for i, header := range records.headers {
+		encoded, err := json.Marshal(header)
+		if err != nil {
+			return err
+		}
+
+		headers[i] = encoded
+	}
+
+	buffered := bufio.NewWriter(writer)
+	buffered.WriteString("[")
+
+	for i, row := range records.rows {
+		if i > 0 {
+			buffered.WriteString(",")
+		}
+
+		buffered.WriteString("\n  {")
+
+		for j, field := range row {
+			if j > 0 {
+				buffered.WriteString(", ")
+			}
+
+			buffered.Write(headers[j])
+			buffered.WriteString(": ")
+
+			if records.numeric[j] {
+				buffered.Write(encodeReportNumber(field, records.numbers[i][j]))
+				continue
+			}
+
+			encoded, err := encodeReportText(field)
+			if err != nil {
+				return err
+			}
+
+			buffered.Write(encoded)
+		}
+
+		buffered.WriteString("}")
+	}
+
+	if len(records.rows) > 0 {
+		buffered.WriteString("\n")
+	}
+
+	buffered.WriteString("]\n")
+
+	return buffered.Flush()
+}
+
+// encodeReportNumber encodes the given report number, formatted as the given
+// field, as a JSON number. The missing values, such as NaN, are encoded as null.
+func encodeReportNumber(field string, number float64) []byte {
+	if !isFinite(number) {
+		return []byte("null")
+	}
+
+	return []byte(field)
+}
+
+// encodeReportText encodes the given report field as a JSON string. The empty
+// fields are encoded as null.
+func encodeReportText(field string) ([]byte, error) {
+	if field == "" {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(field)
+}
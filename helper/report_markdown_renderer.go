@@ -0,0 +1,70 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// markdownReportRenderer renders the report data as a Markdown table.
+type markdownReportRenderer struct{}
+
+// NewMarkdownReportRenderer returns a new renderer writing the report title as
+// a heading, and the report dates and column values as a Markdown table, for
+// the documents and the pull request comments.
+func NewMarkdownReportRenderer() ReportRenderer {
+	return markdownReportRenderer{}
+}
+
+// Render writes the given report to the given writer.
+func (markdownReportRenderer) Render(report *Report, writer io.Writer) error {
+	records := newReportRecords(report)
+
+	buffered := bufio.NewWriter(writer)
+
+	if report.Title != "" {
+		buffered.WriteString("# " + escapeMarkdown(report.Title) + "\n\n")
+	}
+
+	headers := make([]string, len(records.headers))
+	for i, header := range records.headers {
+		headers[i] = escapeMarkdown(header)
+	}
+
+	writeMarkdownRow(buffered, headers)
+
+	separators := make([]string, len(records.headers))
+	for i, numeric := range records.numeric {
+		separators[i] = "---"
+		if numeric {
+			separators[i] = "---:"
+		}
+	}
+
+	writeMarkdownRow(buffered, separators)
+
+	for _, row := range records.rows {
+		fields := make([]string, len(row))
+		for i, field := range row {
+			fields[i] = escapeMarkdown(field)
+		}
+
+		writeMarkdownRow(buffered, fields)
+	}
+
+	return buffered.Flush()
+}
+
+// writeMarkdownRow writes the given fields as a Markdown table row.
+func writeMarkdownRow(writer *bufio.Writer, fields []string) {
+	writer.WriteString("| " + strings.Join(fields, " | ") + " |\n")
+}
+
+// escapeMarkdown escapes the characters that would break a Markdown table.
+func escapeMarkdown(text string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ", "\r", "").Replace(text)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"io"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ReportRenderer defines the interface for rendering a report in a specific
+// format, such as HTML for viewing, or CSV for further analysis.
+type ReportRenderer interface {
+	// Render writes the given report to the given writer.
+	Render(report *Report, writer io.Writer) error
+}
+
+// reportRenderersMu guards reportRenderers against concurrent registration and lookup.
+var reportRenderersMu sync.RWMutex
+
+// reportRenderers provides mapping from the file extensions to the report renderers.
+var reportRenderers = map[string]ReportRenderer{
+	".html":     NewHTMLReportRenderer(),
+	".csv":      NewCSVReportRenderer(),
+	".json":     NewJSONReportRenderer(),
+	".md":       NewMarkdownReportRenderer(),
+	".markdown": NewMarkdownReportRenderer(),
+}
+
+// RegisterReportRenderer registers the given renderer for the given file
+// extension, such as ".csv".
+func RegisterReportRenderer(extension string, renderer ReportRenderer) {
+	reportRenderersMu.Lock()
+	defer reportRenderersMu.Unlock()
+
+	reportRenderers[strings.ToLower(extension)] = renderer
+}
+
+// ReportRendererForFile returns the renderer registered for the extension of
+// the given file name, or the HTML renderer if there is none.
+func ReportRendererForFile(fileName string) ReportRenderer {
+	reportRenderersMu.RLock()
+	renderer, ok := reportRenderers[strings.ToLower(filepath.Ext(fileName))]
+	reportRenderersMu.RUnlock()
+
+	if !ok {
+		return NewHTMLReportRenderer()
+	}
+
+	return renderer
+}
+
+// htmlReportRenderer renders the report as HTML with charts.
+type htmlReportRenderer struct{}
+
+// NewHTMLReportRenderer returns a new renderer writing the report as HTML with
+// charts, which are interactive or offline based on the report settings.
+func NewHTMLReportRenderer() ReportRenderer {
+	return htmlReportRenderer{}
+}
+
+// Render writes the given report to the given writer.
+func (htmlReportRenderer) Render(report *Report, writer io.Writer) error {
+	return report.writeHTMLToWriter(writer)
+}
+
+// reportRecords holds the report data as records of text values, with the
+// dates formatted by the report date format, for the data export renderers.
+// The numbers of the numeric fields are kept along with their text values.
+type reportRecords struct {
+	headers []string
+	numeric []bool
+	rows    [][]string
+	numbers [][]float64
+}
+
+// newReportRecords reads the dates and the typed values of all columns of the
+// report as records. The styled columns span as many fields as their values,
+// the missing values are NaN, and the missing annotations are empty. The
+// repeated headers are numbered to keep them unique.
+func newReportRecords(r *Report) *reportRecords {
+	records := &reportRecords{
+		headers: []string{"Date"},
+		numeric: []bool{false},
+	}
+
+	for _, column := range r.Columns {
+		for _, header := range reportColumnHeaders(column) {
+			records.headers = append(records.headers, header)
+			records.numeric = append(records.numeric, column.Role() != "annotation")
+		}
+	}
+
+	records.headers = uniqueReportHeaders(records.headers)
+
+	for date := range r.Date {
+		row := []string{date.Format(r.DateFormat)}
+		numbers := []float64{math.NaN()}
+
+		for _, column := range r.Columns {
+			cell := readReportCell(column)
+
+			if column.Role() == "annotation" {
+				row = append(row, cell.text)
+				numbers = append(numbers, math.NaN())
+				continue
+			}
+
+			for i := 0; i < reportColumnCells(column); i++ {
+				number := math.NaN()
+				if i < len(cell.numbers) {
+					number = cell.numbers[i]
+				}
+
+				row = append(row, strconv.FormatFloat(number, 'f', -1, 64))
+				numbers = append(numbers, number)
+			}
+		}
+
+		records.rows = append(records.rows, row)
+		records.numbers = append(records.numbers, numbers)
+	}
+
+	return records
+}
+
+// uniqueReportHeaders numbers the repeated headers, such as "Close" and
+// "Close 2", so that each header names a single field.
+func uniqueReportHeaders(headers []string) []string {
+	seen := make(map[string]bool, len(headers))
+	unique := make([]string, len(headers))
+
+	for i, header := range headers {
+		name := header
+		for n := 2; seen[name]; n++ {
+			name = strings.TrimSpace(header + " " + strconv.Itoa(n))
+		}
+
+		seen[name] = true
+		unique[i] = name
+	}
+
+	return unique
+}
+
+// reportColumnHeaders returns the headers for the values of the given column.
+func reportColumnHeaders(column ReportColumn) []string {
+	name := column.Name()
+	if name == "" && column.Role() == "annotation" {
+		name = "Annotation"
+	}
+
+	var suffixes []string
+
+	switch reportColumnStyle(column) {
+	case ReportStyleCandlesticks:
+		suffixes = []string{"Low", "Open", "Close", "High"}
+
+	case ReportStyleBand:
+		suffixes = []string{"Lower", "Upper"}
+
+	default:
+		cells := reportColumnCells(column)
+		if cells == 1 {
+			return []string{name}
+		}
+
+		for i := 1; i <= cells; i++ {
+			suffixes = append(suffixes, strconv.Itoa(i))
+		}
+	}
+
+	headers := make([]string, len(suffixes))
+	for i, suffix := range suffixes {
+		headers[i] = strings.TrimSpace(name + " " + suffix)
+	}
+
+	return headers
+}
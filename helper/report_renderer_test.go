@@ -0,0 +1,173 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"io"
+	"math"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func newRendererTestReport() *helper.Report {
+	dates := helper.SliceToChan([]time.Time{
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	})
+
+	report := helper.NewReport("Test | Report", dates)
+	report.AddColumn(helper.NewCandlestickReportColumn("Price",
+		helper.SliceToChan([]float64{10, 11}),
+		helper.SliceToChan([]float64{12, 13}),
+		helper.SliceToChan([]float64{9, 10}),
+		helper.SliceToChan([]float64{11, 12.5}),
+	))
+	report.AddColumn(helper.NewNumericReportColumn("SMA", helper.SliceToChan([]float64{math.NaN(), 11.75})))
+	report.AddColumn(helper.NewAnnotationReportColumn(helper.SliceToChan([]string{"B", ""})))
+
+	return report
+}
+
+func TestCSVReportRenderer(t *testing.T) {
+	var sb strings.Builder
+
+	err := newRendererTestReport().WriteToWriter(&sb, helper.NewCSVReportRenderer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Date,Price Low,Price Open,Price Close,Price High,SMA,Annotation\n" +
+		"2024-01-02,9,10,11,12,NaN,B\n" +
+		"2024-01-03,10,11,12.5,13,11.75,\n"
+
+	if sb.String() != expected {
+		t.Fatalf("actual %q expected %q", sb.String(), expected)
+	}
+}
+
+func TestJSONReportRenderer(t *testing.T) {
+	var sb strings.Builder
+
+	err := newRendererTestReport().WriteToWriter(&sb, helper.NewJSONReportRenderer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[\n" +
+		`  {"Date": "2024-01-02", "Price Low": 9, "Price Open": 10, "Price Close": 11, "Price High": 12, "SMA": null, "Annotation": "B"},` + "\n" +
+		`  {"Date": "2024-01-03", "Price Low": 10, "Price Open": 11, "Price Close": 12.5, "Price High": 13, "SMA": 11.75, "Annotation": null}` + "\n" +
+		"]\n"
+
+	if sb.String() != expected {
+		t.Fatalf("actual %q expected %q", sb.String(), expected)
+	}
+}
+
+func TestJSONReportRendererUniqueKeys(t *testing.T) {
+	dates := helper.SliceToChan([]time.Time{
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+
+	report := helper.NewReport("Test", dates)
+	report.AddColumn(helper.NewNumericReportColumn("Close", helper.SliceToChan([]float64{1000000})))
+	report.AddColumn(helper.NewNumericReportColumn("Close", helper.SliceToChan([]float64{2.5})))
+	report.AddColumn(helper.NewNumericReportColumn("Close 2", helper.SliceToChan([]float64{3})))
+
+	var sb strings.Builder
+
+	err := report.WriteToWriter(&sb, helper.NewJSONReportRenderer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "[\n" +
+		`  {"Date": "2024-01-02", "Close": 1000000, "Close 2": 2.5, "Close 2 2": 3}` + "\n" +
+		"]\n"
+
+	if sb.String() != expected {
+		t.Fatalf("actual %q expected %q", sb.String(), expected)
+	}
+}
+
+func TestMarkdownReportRenderer(t *testing.T) {
+	var sb strings.Builder
+
+	err := newRendererTestReport().WriteToWriter(&sb, helper.NewMarkdownReportRenderer())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "# Test \\| Report\n\n" +
+		"| Date | Price Low | Price Open | Price Close | Price High | SMA | Annotation |\n" +
+		"| --- | ---: | ---: | ---: | ---: | ---: | --- |\n" +
+		"| 2024-01-02 | 9 | 10 | 11 | 12 | NaN | B |\n" +
+		"| 2024-01-03 | 10 | 11 | 12.5 | 13 | 11.75 |  |\n"
+
+	if sb.String() != expected {
+		t.Fatalf("actual %q expected %q", sb.String(), expected)
+	}
+}
+
+func TestReportWriteToFileByExtension(t *testing.T) {
+	fileName := "report.csv"
+	defer helper.Remove(t, fileName)
+
+	err := newRendererTestReport().WriteToFile(fileName, helper.ReportRendererForFile(fileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(string(content), "Date,Price Low") {
+		t.Fatalf("not a CSV report: %q", content)
+	}
+}
+
+func TestReportWriteToFileDefaultsToHTML(t *testing.T) {
+	fileName := "report.csv"
+	defer helper.Remove(t, fileName)
+
+	err := newRendererTestReport().WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), "<html") {
+		t.Fatalf("not an HTML report: %q", content)
+	}
+}
+
+type testReportRenderer struct{}
+
+func (testReportRenderer) Render(report *helper.Report, writer io.Writer) error {
+	_, err := io.WriteString(writer, report.Title)
+	return err
+}
+
+func TestRegisterReportRenderer(t *testing.T) {
+	helper.RegisterReportRenderer(".TXT", testReportRenderer{})
+
+	_, ok := helper.ReportRendererForFile("report.txt").(testReportRenderer)
+	if !ok {
+		t.Fatal("renderer is not registered")
+	}
+
+	if helper.ReportRendererForFile("report.unknown") == nil {
+		t.Fatal("no default renderer")
+	}
+}
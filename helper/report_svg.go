@@ -0,0 +1,658 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// reportSVGWidth is the width of the offline report charts.
+	reportSVGWidth = 1000
+
+	// reportSVGMainHeight is the height of the main offline report chart.
+	reportSVGMainHeight = 400
+
+	// reportSVGHeight is the height of the other offline report charts.
+	reportSVGHeight = 200
+
+	// reportSVGLeft is the left margin of the plot area for the value labels.
+	reportSVGLeft = 70
+
+	// reportSVGRight is the right margin of the plot area for the legend.
+	reportSVGRight = 160
+
+	// reportSVGTop is the top margin of the plot area.
+	reportSVGTop = 20
+
+	// reportSVGBottom is the bottom margin of the plot area for the date labels.
+	reportSVGBottom = 30
+
+	// reportSVGValueTicks is the approximate number of the value labels.
+	reportSVGValueTicks = 5
+
+	// reportSVGDateTicks is the maximum number of the date labels.
+	reportSVGDateTicks = 6
+
+	// reportSVGRising is the color of the rising candles and the buy markers.
+	reportSVGRising = "#26a69a"
+
+	// reportSVGFalling is the color of the falling candles and the sell markers.
+	reportSVGFalling = "#ef5350"
+)
+
+// reportSVGColors is the color palette for the chart series.
+var reportSVGColors = []string{
+	"#3366cc", "#dc3912", "#ff9900", "#109618",
+	"#990099", "#0099c6", "#dd4477", "#66aa00",
+}
+
+// reportSVGTheme is the colors of the chart elements for a report theme.
+type reportSVGTheme struct {
+	grid  string
+	label string
+	text  string
+}
+
+// reportSVGThemes provides mapping from the report themes to the chart colors.
+var reportSVGThemes = map[string]reportSVGTheme{
+	ReportThemeLight: {grid: "#e0e0e0", label: "#666666", text: "#333333"},
+	ReportThemeDark:  {grid: "#30363d", label: "#8b949e", text: "#c9d1d9"},
+}
+
+// reportTable holds the dates and the values of the report columns, read
+// ahead of the rendering, since the charts are drawn from the complete data.
+type reportTable struct {
+	dates []time.Time
+	cells [][]reportCell
+}
+
+// reportCell is the typed value of a report column for a date. The data
+// columns hold their numbers in the order of their Value, and the annotation
+// columns hold their text.
+type reportCell struct {
+	numbers []float64
+	text    string
+}
+
+// typedReportColumn is implemented by the report columns that provide their
+// next values as numbers or text, without formatting them for the interactive
+// charts.
+type typedReportColumn interface {
+	// cell returns the next typed value for the report column.
+	cell() reportCell
+}
+
+// reportSeries is a series drawn on a chart along with its annotations. The
+// lines and the bars use the values, the candlesticks use the opening, high,
+// low, and closing values, and the bands use the lower and the upper values.
+type reportSeries struct {
+	name        string
+	style       string
+	color       string
+	values      []float64
+	opens       []float64
+	highs       []float64
+	lows        []float64
+	closes      []float64
+	lowers      []float64
+	uppers      []float64
+	annotations []string
+}
+
+// newReportTable reads the dates and the values of all columns of the report.
+func newReportTable(r *Report) *reportTable {
+	table := &reportTable{
+		cells: make([][]reportCell, len(r.Columns)),
+	}
+
+	for date := range r.Date {
+		table.dates = append(table.dates, date)
+
+		for i, column := range r.Columns {
+			table.cells[i] = append(table.cells[i], readReportCell(column))
+		}
+	}
+
+	return table
+}
+
+// series returns the series for the given view. The annotation and the band
+// columns are attached to the data column preceding them, similar to the
+// interactive charts.
+func (t *reportTable) series(columns []ReportColumn, view []int) []*reportSeries {
+	var series []*reportSeries
+	var host *reportSeries
+
+	colors := 0
+	nextColor := func() string {
+		color := reportSVGColors[colors%len(reportSVGColors)]
+		colors++
+		return color
+	}
+
+	for _, columnID := range view {
+		column := columns[columnID-1]
+		cells := t.cells[columnID-1]
+
+		if column.Role() == "annotation" {
+			if host == nil {
+				host = &reportSeries{}
+				series = append(series, host)
+			}
+
+			host.annotations = make([]string, len(cells))
+			for i, cell := range cells {
+				host.annotations[i] = cell.text
+			}
+
+			continue
+		}
+
+		values := reportCellValues(cells, reportColumnCells(column))
+
+		s := &reportSeries{
+			name:  column.Name(),
+			style: reportColumnStyle(column),
+		}
+
+		switch s.style {
+		case ReportStyleBand:
+			s.lowers, s.uppers = values[0], values[1]
+
+			if host != nil && host.color != "" {
+				s.color = host.color
+			} else {
+				s.color = nextColor()
+			}
+
+			series = append(series, s)
+			continue
+
+		case ReportStyleCandlesticks:
+			s.lows, s.opens, s.closes, s.highs = values[0], values[1], values[2], values[3]
+			s.color = reportSVGRising
+			colors++
+
+		default:
+			s.values = values[0]
+			s.color = nextColor()
+		}
+
+		series = append(series, s)
+		host = s
+	}
+
+	return series
+}
+
+// renderChart renders the chart for the given view as an inline SVG image.
+func (t *reportTable) renderChart(columns []ReportColumn, view []int, height int, dateFormat, theme string) string {
+	series := t.series(columns, view)
+
+	plot := newReportPlot(len(t.dates), height, theme)
+	plot.fitValues(series)
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" width="%d" height="%d" font-family="sans-serif" font-size="12" role="img">`,
+		reportSVGWidth, height, reportSVGWidth, height)
+	sb.WriteString("\n")
+
+	plot.renderValueAxis(&sb)
+	plot.renderDateAxis(&sb, t.dates, dateFormat)
+
+	// The bands and the bars are drawn first, so that the other series stay
+	// visible on top of them.
+	for _, style := range []string{ReportStyleBand, ReportStyleBars, ReportStyleLine, ReportStyleCandlesticks} {
+		for _, s := range series {
+			if s.style != style {
+				continue
+			}
+
+			switch style {
+			case ReportStyleBand:
+				plot.renderBand(&sb, s)
+			case ReportStyleBars:
+				plot.renderBars(&sb, s)
+			case ReportStyleLine:
+				plot.renderLine(&sb, s)
+			case ReportStyleCandlesticks:
+				plot.renderCandlesticks(&sb, s)
+			}
+		}
+	}
+
+	legend := 0
+	for _, s := range series {
+		plot.renderAnnotations(&sb, s)
+
+		if s.style != "" {
+			plot.renderLegend(&sb, s, legend)
+			legend++
+		}
+	}
+
+	sb.WriteString("</svg>")
+
+	return sb.String()
+}
+
+// reportPlot maps the data points to the plot area of a chart.
+type reportPlot struct {
+	count  int
+	height int
+	low    float64
+	high   float64
+	step   float64
+	theme  reportSVGTheme
+}
+
+// newReportPlot initializes a new plot for the given number of points using
+// the colors of the given theme, or the light theme if it is unknown.
+func newReportPlot(count, height int, theme string) *reportPlot {
+	colors, ok := reportSVGThemes[theme]
+	if !ok {
+		colors = reportSVGThemes[ReportThemeLight]
+	}
+
+	return &reportPlot{
+		count:  count,
+		height: height,
+		low:    0,
+		high:   1,
+		step:   0.25,
+		theme:  colors,
+	}
+}
+
+// fitValues fits the value range of the plot to the finite values of the
+// given series, rounded to the nice value label steps. The range includes
+// zero when there are bars, since the bars start from zero.
+func (p *reportPlot) fitValues(series []*reportSeries) {
+	low := math.Inf(1)
+	high := math.Inf(-1)
+
+	for _, s := range series {
+		if s.style == ReportStyleBars {
+			low = math.Min(low, 0)
+			high = math.Max(high, 0)
+		}
+
+		for _, values := range [][]float64{s.values, s.highs, s.lows, s.lowers, s.uppers} {
+			for _, value := range values {
+				if isFinite(value) {
+					low = math.Min(low, value)
+					high = math.Max(high, value)
+				}
+			}
+		}
+	}
+
+	p.fitRange(low, high)
+}
+
+// fitRange fits the value range of the plot to the given range, rounded to
+// the nice value label steps.
+func (p *reportPlot) fitRange(low, high float64) {
+	if low > high {
+		return
+	}
+
+	if low == high {
+		padding := math.Max(math.Abs(low)*0.05, 1)
+		low -= padding
+		high += padding
+	}
+
+	p.step = niceStep((high - low) / reportSVGValueTicks)
+	p.low = math.Floor(low/p.step) * p.step
+	p.high = math.Ceil(high/p.step) * p.step
+}
+
+// slot returns the horizontal space of each point.
+func (p *reportPlot) slot() float64 {
+	return float64(reportSVGWidth-reportSVGLeft-reportSVGRight) / float64(max(p.count, 1))
+}
+
+// x returns the horizontal position of the center of the given point.
+func (p *reportPlot) x(i int) float64 {
+	return reportSVGLeft + p.slot()*(float64(i)+0.5)
+}
+
+// y returns the vertical position of the given value.
+func (p *reportPlot) y(value float64) float64 {
+	height := float64(p.height - reportSVGTop - reportSVGBottom)
+	return reportSVGTop + height*(p.high-value)/(p.high-p.low)
+}
+
+// renderValueAxis renders the value labels along with the horizontal grid lines.
+func (p *reportPlot) renderValueAxis(sb *strings.Builder) {
+	decimals := max(0, int(-math.Floor(math.Log10(p.step))))
+
+	for i := 0; ; i++ {
+		value := p.low + float64(i)*p.step
+		if value > p.high+p.step/2 {
+			break
+		}
+
+		y := p.y(value)
+
+		fmt.Fprintf(sb, `<line x1="%d" y1="%.1f" x2="%d" y2="%.1f" stroke="%s"/>`,
+			reportSVGLeft, y, reportSVGWidth-reportSVGRight, y, p.theme.grid)
+		fmt.Fprintf(sb, `<text x="%d" y="%.1f" text-anchor="end" dominant-baseline="middle" fill="%s">%.*f</text>`,
+			reportSVGLeft-8, y, p.theme.label, decimals, value)
+		sb.WriteString("\n")
+	}
+}
+
+// renderDateAxis renders the date labels for the evenly spaced points.
+func (p *reportPlot) renderDateAxis(sb *strings.Builder, dates []time.Time, dateFormat string) {
+	count := min(len(dates), reportSVGDateTicks)
+	y := p.height - reportSVGBottom + 18
+
+	for k := 0; k < count; k++ {
+		i := 0
+		if count > 1 {
+			i = k * (len(dates) - 1) / (count - 1)
+		}
+
+		fmt.Fprintf(sb, `<text x="%.1f" y="%d" text-anchor="middle" fill="%s">%s</text>`,
+			p.x(i), y, p.theme.label, html.EscapeString(dates[i].Format(dateFormat)))
+		sb.WriteString("\n")
+	}
+}
+
+// renderLine renders the given series as a line, leaving gaps for the
+// missing values.
+func (p *reportPlot) renderLine(sb *strings.Builder, s *reportSeries) {
+	var path strings.Builder
+
+	command := "M"
+	for i, value := range s.values {
+		if !isFinite(value) {
+			command = "M"
+			continue
+		}
+
+		fmt.Fprintf(&path, "%s%.1f %.1f ", command, p.x(i), p.y(value))
+		command = "L"
+	}
+
+	if path.Len() == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, `<path d="%s" fill="none" stroke="%s" stroke-width="1.5"><title>%s</title></path>`,
+		strings.TrimSpace(path.String()), s.color, html.EscapeString(s.name))
+	sb.WriteString("\n")
+}
+
+// renderBars renders the given series as bars starting from zero.
+func (p *reportPlot) renderBars(sb *strings.Builder, s *reportSeries) {
+	width := math.Max(p.slot()*0.7, 0.5)
+	zero := p.y(math.Max(p.low, math.Min(p.high, 0)))
+
+	for i, value := range s.values {
+		if !isFinite(value) {
+			continue
+		}
+
+		y := p.y(value)
+
+		fmt.Fprintf(sb, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s" fill-opacity="0.6"/>`,
+			p.x(i)-width/2, math.Min(y, zero), width, math.Abs(zero-y), s.color)
+	}
+
+	sb.WriteString("\n")
+}
+
+// renderCandlesticks renders the given series as candlesticks, with a wick
+// from the low to the high, and a body from the opening to the closing.
+func (p *reportPlot) renderCandlesticks(sb *strings.Builder, s *reportSeries) {
+	width := math.Max(p.slot()*0.6, 0.5)
+
+	for i := range s.closes {
+		open, high, low, closing := s.opens[i], s.highs[i], s.lows[i], s.closes[i]
+		if !isFinite(open) || !isFinite(high) || !isFinite(low) || !isFinite(closing) {
+			continue
+		}
+
+		color := reportSVGRising
+		if closing < open {
+			color = reportSVGFalling
+		}
+
+		x := p.x(i)
+		top := p.y(math.Max(open, closing))
+		bottom := p.y(math.Min(open, closing))
+
+		fmt.Fprintf(sb, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s"/>`,
+			x, p.y(high), x, p.y(low), color)
+		fmt.Fprintf(sb, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" fill="%s"/>`,
+			x-width/2, top, width, math.Max(bottom-top, 1), color)
+	}
+
+	sb.WriteString("\n")
+}
+
+// renderBand renders the given series as a shaded area between its lower and
+// its upper values, leaving gaps for the missing values.
+func (p *reportPlot) renderBand(sb *strings.Builder, s *reportSeries) {
+	var segment []int
+
+	flush := func() {
+		if len(segment) == 0 {
+			return
+		}
+
+		var path strings.Builder
+
+		for k, i := range segment {
+			command := "L"
+			if k == 0 {
+				command = "M"
+			}
+
+			fmt.Fprintf(&path, "%s%.1f %.1f ", command, p.x(i), p.y(s.uppers[i]))
+		}
+
+		for k := len(segment) - 1; k >= 0; k-- {
+			i := segment[k]
+			fmt.Fprintf(&path, "L%.1f %.1f ", p.x(i), p.y(s.lowers[i]))
+		}
+
+		fmt.Fprintf(sb, `<path d="%sZ" fill="%s" fill-opacity="0.15" stroke="none"><title>%s</title></path>`,
+			path.String(), s.color, html.EscapeString(s.name))
+		sb.WriteString("\n")
+
+		segment = segment[:0]
+	}
+
+	for i := range s.uppers {
+		if isFinite(s.lowers[i]) && isFinite(s.uppers[i]) {
+			segment = append(segment, i)
+		} else {
+			flush()
+		}
+	}
+
+	flush()
+}
+
+// renderAnnotations renders the annotations of the given series. The buy and
+// the sell annotations of the candlesticks are drawn as markers below and
+// above the candles, and the other annotations are drawn above the values,
+// or at the top of the plot if there is no value.
+func (p *reportPlot) renderAnnotations(sb *strings.Builder, s *reportSeries) {
+	color := s.color
+	if color == "" {
+		color = p.theme.text
+	}
+
+	for i, annotation := range s.annotations {
+		if annotation == "" {
+			continue
+		}
+
+		x := p.x(i)
+		y := float64(reportSVGTop + 12)
+
+		switch {
+		case s.style == ReportStyleCandlesticks && isFinite(s.lows[i]) && isFinite(s.highs[i]):
+			p.renderMarker(sb, annotation, x, p.y(s.lows[i]), p.y(s.highs[i]))
+			continue
+
+		case i < len(s.values) && isFinite(s.values[i]):
+			y = p.y(s.values[i])
+			fmt.Fprintf(sb, `<circle cx="%.1f" cy="%.1f" r="3" fill="%s"/>`, x, y, color)
+			y -= 8
+		}
+
+		fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" text-anchor="middle" font-size="11" fill="%s">%s</text>`,
+			x, y, color, html.EscapeString(annotation))
+		sb.WriteString("\n")
+	}
+}
+
+// renderMarker renders the given annotation of a candle. A buy annotation is
+// drawn as an upward arrow below the low, a sell annotation is drawn as a
+// downward arrow above the high, and the other annotations are skipped.
+func (p *reportPlot) renderMarker(sb *strings.Builder, annotation string, x, low, high float64) {
+	switch annotation {
+	case "B":
+		fmt.Fprintf(sb, `<path d="M%.1f %.1f L%.1f %.1f L%.1f %.1f Z" fill="%s"/>`,
+			x, low+6, x-5, low+14, x+5, low+14, reportSVGRising)
+		fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" text-anchor="middle" font-size="11" fill="%s">%s</text>`,
+			x, low+26, reportSVGRising, html.EscapeString(annotation))
+
+	case "S":
+		fmt.Fprintf(sb, `<path d="M%.1f %.1f L%.1f %.1f L%.1f %.1f Z" fill="%s"/>`,
+			x, high-6, x-5, high-14, x+5, high-14, reportSVGFalling)
+		fmt.Fprintf(sb, `<text x="%.1f" y="%.1f" text-anchor="middle" font-size="11" fill="%s">%s</text>`,
+			x, high-18, reportSVGFalling, html.EscapeString(annotation))
+
+	default:
+		return
+	}
+
+	sb.WriteString("\n")
+}
+
+// renderLegend renders the legend entry for the given series, drawing the
+// lines as a line, and the other styles as a filled box.
+func (p *reportPlot) renderLegend(sb *strings.Builder, s *reportSeries, index int) {
+	x := reportSVGWidth - reportSVGRight + 15
+	y := reportSVGTop + 10 + index*18
+
+	switch s.style {
+	case ReportStyleLine:
+		fmt.Fprintf(sb, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s" stroke-width="3"/>`, x, y, x+20, y, s.color)
+
+	case ReportStyleBand:
+		fmt.Fprintf(sb, `<rect x="%d" y="%d" width="20" height="10" fill="%s" fill-opacity="0.15"/>`, x, y-5, s.color)
+
+	default:
+		fmt.Fprintf(sb, `<rect x="%d" y="%d" width="20" height="10" fill="%s"/>`, x, y-5, s.color)
+	}
+
+	fmt.Fprintf(sb, `<text x="%d" y="%d" dominant-baseline="middle" fill="%s">%s</text>`, x+26, y, p.theme.text, html.EscapeString(s.name))
+	sb.WriteString("\n")
+}
+
+// niceStep returns the smallest step of 1, 2 or 5 times a power of ten that
+// is not less than the given step.
+func niceStep(step float64) float64 {
+	magnitude := math.Pow(10, math.Floor(math.Log10(step)))
+
+	for _, factor := range []float64{1, 2, 5} {
+		if step <= factor*magnitude {
+			return factor * magnitude
+		}
+	}
+
+	return 10 * magnitude
+}
+
+// readReportCell reads the next typed value for the given report column. The
+// values of the columns that do not implement the typedReportColumn interface
+// are parsed from their Value.
+func readReportCell(column ReportColumn) reportCell {
+	if typed, ok := column.(typedReportColumn); ok {
+		return typed.cell()
+	}
+
+	value := column.Value()
+
+	if column.Role() == "annotation" {
+		return reportCell{text: parseReportAnnotation(value)}
+	}
+
+	fields := strings.Split(value, ",")
+
+	cell := reportCell{numbers: make([]float64, len(fields))}
+	for i, field := range fields {
+		cell.numbers[i] = parseReportNumber(field)
+	}
+
+	return cell
+}
+
+// reportCellValues splits the numbers of the given report cells into the given
+// number of value slices, using NaN for the missing values.
+func reportCellValues(cells []reportCell, count int) [][]float64 {
+	values := make([][]float64, count)
+	for k := range values {
+		values[k] = make([]float64, len(cells))
+	}
+
+	for i, cell := range cells {
+		for k := range values {
+			values[k][i] = math.NaN()
+
+			if k < len(cell.numbers) {
+				values[k][i] = cell.numbers[k]
+			}
+		}
+	}
+
+	return values
+}
+
+// parseReportNumber parses the given numeric report cell, returning NaN for
+// the missing values.
+func parseReportNumber(cell string) float64 {
+	value, err := strconv.ParseFloat(strings.TrimSpace(cell), 64)
+	if err != nil {
+		return math.NaN()
+	}
+
+	return value
+}
+
+// parseReportAnnotation parses the given annotation report cell, returning an
+// empty string for the missing values.
+func parseReportAnnotation(cell string) string {
+	if cell == "null" {
+		return ""
+	}
+
+	annotation, err := strconv.Unquote(cell)
+	if err != nil {
+		return cell
+	}
+
+	return annotation
+}
+
+// isFinite checks whether the given value is neither NaN nor infinite.
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
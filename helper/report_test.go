@@ -5,7 +5,11 @@
 package helper_test
 
 import (
+	"io"
+	"math"
+	"strings"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -75,3 +79,302 @@ func TestReportWriteToFileFailed(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestReportWriteToWriterOffline(t *testing.T) {
+	type Row struct {
+		Date       time.Time `format:"2006-01-02"`
+		Close      float64
+		Annotation string
+	}
+
+	input, err := helper.ReadFromCsvFile[Row]("testdata/report.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 3)
+	dates := helper.Map(inputs[0], func(row *Row) time.Time { return row.Date })
+	closes := helper.Map(inputs[1], func(row *Row) float64 { return row.Close })
+	annotations := helper.Map(inputs[2], func(row *Row) string { return row.Annotation })
+
+	closesSplice := helper.Duplicate(closes, 2)
+
+	report := helper.NewReport("Test Report", dates)
+	report.Offline = true
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closesSplice[0]))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+	report.AddColumn(helper.NewNumericReportColumn("Gaps", helper.Map(closesSplice[1], func(float64) float64 { return math.NaN() })), 1)
+
+	var sb strings.Builder
+
+	err = report.WriteToWriter(&sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := sb.String()
+
+	for _, external := range []string{"<script", "<link", "gstatic.com", "jsdelivr.net"} {
+		if strings.Contains(actual, external) {
+			t.Fatalf("offline report contains %q", external)
+		}
+	}
+
+	if count := strings.Count(actual, "<svg"); count != 2 {
+		t.Fatalf("actual %v expected 2", count)
+	}
+
+	if count := strings.Count(actual, "<path"); count != 1 {
+		t.Fatalf("actual %v expected 1", count)
+	}
+
+	if !strings.Contains(actual, ">B</text>") || !strings.Contains(actual, ">S</text>") {
+		t.Fatal("annotations not found")
+	}
+
+	if !strings.Contains(actual, ">2022-11-30</text>") {
+		t.Fatal("date label not found")
+	}
+
+	if !strings.Contains(actual, helper.ReportCSS()) {
+		t.Fatal("stylesheet not embedded")
+	}
+}
+
+func TestReportCopyrightYears(t *testing.T) {
+	for _, offline := range []bool{false, true} {
+		report := helper.NewReport("Test Report", helper.SliceToChan([]time.Time{}))
+		report.Offline = offline
+
+		var sb strings.Builder
+
+		err := report.WriteToWriter(&sb)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(sb.String(), "Copyright (c) 2021-2026") {
+			t.Fatalf("copyright years not found offline %v", offline)
+		}
+	}
+}
+
+// customReportColumn is a report column implemented outside of the helper
+// package, providing only its formatted values.
+type customReportColumn struct {
+	values []string
+}
+
+func (*customReportColumn) Name() string {
+	return "Custom"
+}
+
+func (*customReportColumn) Type() string {
+	return "number"
+}
+
+func (*customReportColumn) Role() string {
+	return "data"
+}
+
+func (c *customReportColumn) Value() string {
+	value := c.values[0]
+	c.values = c.values[1:]
+	return value
+}
+
+func TestReportWriteToWriterOfflineCustomColumn(t *testing.T) {
+	dates := helper.SliceToChan([]time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	})
+
+	report := helper.NewReport("Test Report", dates)
+	report.Offline = true
+	report.AddColumn(&customReportColumn{values: []string{"1", "2.5", "NaN"}})
+
+	var sb strings.Builder
+
+	err := report.WriteToWriter(&sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count := strings.Count(sb.String(), "<path"); count != 1 {
+		t.Fatalf("actual %v expected 1", count)
+	}
+}
+
+func TestReportWriteToWriterOfflineMarkers(t *testing.T) {
+	dates := helper.SliceToChan([]time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+	})
+
+	prices := helper.Duplicate(helper.SliceToChan([]float64{10, 11, 12}), 4)
+	annotations := helper.SliceToChan([]string{"B", "S", "X"})
+
+	report := helper.NewReport("Test Report", dates)
+	report.Offline = true
+	report.AddColumn(helper.NewCandlestickReportColumn("Price", prices[0], prices[1], prices[2], prices[3]))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	var sb strings.Builder
+
+	err := report.WriteToWriter(&sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := sb.String()
+
+	if !strings.Contains(actual, ">B</text>") || !strings.Contains(actual, ">S</text>") {
+		t.Fatal("markers not found")
+	}
+
+	if strings.Contains(actual, ">X</text>") {
+		t.Fatal("unknown annotation drawn as a marker")
+	}
+}
+
+func TestReportWriteToWriterStyledColumns(t *testing.T) {
+	type Row struct {
+		Date       time.Time `format:"2006-01-02"`
+		Open       float64
+		High       float64
+		Low        float64
+		Close      float64
+		Volume     int64
+		Annotation string
+	}
+
+	for _, offline := range []bool{false, true} {
+		input, err := helper.ReadFromCsvFile[Row]("testdata/report.csv")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		inputs := helper.Duplicate(input, 10)
+		dates := helper.Map(inputs[0], func(row *Row) time.Time { return row.Date })
+		opens := helper.Map(inputs[1], func(row *Row) float64 { return row.Open })
+		highs := helper.Map(inputs[2], func(row *Row) float64 { return row.High })
+		lows := helper.Map(inputs[3], func(row *Row) float64 { return row.Low })
+		closes := helper.Map(inputs[4], func(row *Row) float64 { return row.Close })
+		volumes := helper.Map(inputs[5], func(row *Row) int64 { return row.Volume })
+		annotations := helper.Map(inputs[6], func(row *Row) string { return row.Annotation })
+		middles := helper.Map(inputs[7], func(row *Row) float64 { return (row.High + row.Low) / 2 })
+		uppers := helper.Map(inputs[8], func(row *Row) float64 { return row.High + 5 })
+		lowers := helper.Map(inputs[9], func(row *Row) float64 { return row.Low - 5 })
+
+		report := helper.NewReport("Test Report", dates)
+		report.Offline = offline
+		report.AddChart()
+
+		report.AddColumn(helper.NewCandlestickReportColumn("Price", opens, highs, lows, closes))
+		report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+		report.AddColumn(helper.NewNumericReportColumn("Middle", middles))
+		report.AddColumn(helper.NewBandReportColumn("Band", lowers, uppers))
+		report.AddColumn(helper.NewBarReportColumn("Volume", volumes), 1)
+
+		var sb strings.Builder
+
+		err = report.WriteToWriter(&sb)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := sb.String()
+
+		var expected []string
+		if offline {
+			expected = []string{
+				// Buy and sell markers.
+				`fill="#26a69a"/><text`,
+				`fill="#ef5350"/><text`,
+				// Band area.
+				`fill-opacity="0.15" stroke="none"><title>Band</title>`,
+				// Volume bars.
+				`fill-opacity="0.6"/>`,
+				// Legend entries.
+				`>Price</text>`,
+				`>Volume</text>`,
+			}
+		} else {
+			expected = []string{
+				`"chartType": "ComboChart"`,
+				`0: { "type": "candlesticks" }`,
+				`1: { "type": "line" }`,
+				`0: { "type": "bars" }`,
+				`"role": "interval"`,
+			}
+		}
+
+		for _, e := range expected {
+			if !strings.Contains(actual, e) {
+				t.Fatalf("offline %v report does not contain %s", offline, e)
+			}
+		}
+	}
+}
+
+func TestReportCustomTemplateAndTheme(t *testing.T) {
+	for _, offline := range []bool{false, true} {
+		dates := helper.SliceToChan([]time.Time{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+
+		report := helper.NewReport("Test Report", dates)
+		report.Offline = offline
+		report.Theme = helper.ReportThemeDark
+		report.CSS = ".custom-css { color: red; }"
+		report.Template = `{{ define "top" }}<table class="parameters"><tr><td>{{ shout "period" }}</td></tr></table>{{ end }}`
+		report.Funcs = template.FuncMap{
+			"shout": strings.ToUpper,
+		}
+		report.AddColumn(helper.NewNumericReportColumn("Close", helper.SliceToChan([]float64{1})))
+
+		var sb strings.Builder
+
+		err := report.WriteToWriter(&sb)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, expected := range []string{`<td>PERIOD</td>`, ".custom-css", "#14161a"} {
+			if !strings.Contains(sb.String(), expected) {
+				t.Fatalf("offline %v report does not contain %s", offline, expected)
+			}
+		}
+	}
+}
+
+func TestReportReplacedTemplate(t *testing.T) {
+	dates := helper.SliceToChan([]time.Time{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+
+	report := helper.NewReport("Test Report", dates)
+	report.Template = `{{ .Title }}: {{ len .Columns }}`
+
+	var sb strings.Builder
+
+	err := report.WriteToWriter(&sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Test Report: 0"
+	if sb.String() != expected {
+		t.Fatalf("actual %q expected %q", sb.String(), expected)
+	}
+}
+
+func TestReportInvalidTemplate(t *testing.T) {
+	report := helper.NewReport("Test Report", helper.SliceToChan([]time.Time{}))
+	report.Template = "{{ unknown }}"
+
+	err := report.WriteToWriter(io.Discard)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// TimeBucket maps a date to the start of the time bucket that it belongs to,
+// such as the start of its day, week, or month. The dates with the same
+// bucket start are grouped together.
+type TimeBucket func(time.Time) time.Time
+
+// DayBucket returns the start of the day of the given date, in the location
+// of the date.
+func DayBucket(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
+
+// WeekBucket returns the start of the week of the given date, in the location
+// of the date. The weeks start on Monday.
+func WeekBucket(date time.Time) time.Time {
+	start := DayBucket(date)
+	return start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+}
+
+// MonthBucket returns the start of the month of the given date, in the
+// location of the date.
+func MonthBucket(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+}
+
+// YearBucket returns the start of the year of the given date, in the location
+// of the date.
+func YearBucket(date time.Time) time.Time {
+	return time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, date.Location())
+}
+
+// NewSessionBucket returns a time bucket for the trading sessions that open at
+// the given time of the day in the given location, such as 9:30 for the US
+// stock market, or 18:00 for the futures markets where a session spans two
+// calendar days. The bucket start is the session open, taken as the wall
+// clock time of the day, so the sessions keep opening at the same local time
+// across the daylight saving time changes.
+//
+// Example:
+//
+//	newYork, _ := time.LoadLocation("America/New_York")
+//	sessions := helper.NewSessionBucket(newYork, 18*time.Hour)
+func NewSessionBucket(location *time.Location, open time.Duration) TimeBucket {
+	return func(date time.Time) time.Time {
+		date = date.In(location)
+		year, month, day := date.Date()
+
+		start := time.Date(year, month, day, 0, 0, 0, int(open), location)
+		if date.Before(start) {
+			start = time.Date(year, month, day-1, 0, 0, 0, int(open), location)
+		}
+
+		return start
+	}
+}
+
+// NewAnchorBucket returns a time bucket that starts at each of the given
+// anchor dates, such as the earnings dates or the swing lows, so that each
+// bucket spans from an anchor to the next one. The dates before the first
+// anchor belong to the zero time bucket.
+//
+// Example:
+//
+//	earnings := helper.NewAnchorBucket(q1Earnings, q2Earnings)
+func NewAnchorBucket(anchors ...time.Time) TimeBucket {
+	sorted := slices.Clone(anchors)
+	slices.SortFunc(sorted, time.Time.Compare)
+
+	return func(date time.Time) time.Time {
+		i, found := slices.BinarySearchFunc(sorted, date, time.Time.Compare)
+		if found {
+			return sorted[i]
+		}
+
+		if i == 0 {
+			return time.Time{}
+		}
+
+		return sorted[i-1]
+	}
+}
+
+// RollingDurationWindowWithContext emits for each value of the given dated
+// series the values within the given duration ending at its date, such as the
+// last 30 calendar days, along with its date. The dates are expected to be in
+// ascending order. Unlike WindowWithContext, the number of values in each
+// window varies with the gaps in the dates, supporting context cancellation.
+//
+// Example:
+//
+//	windows := helper.RollingDurationWindowWithContext(ctx, closings, 30*24*time.Hour)
+//	averages := helper.AggregateWindowsWithContext(ctx, windows, helper.AggregateMean[float64])
+func RollingDurationWindowWithContext[T any](ctx context.Context, c <-chan Dated[T], d time.Duration) <-chan Dated[[]T] {
+	var window []Dated[T]
+
+	return MapWithContext(ctx, c, func(value Dated[T]) Dated[[]T] {
+		window = append(window, value)
+
+		from := value.Date.Add(-d)
+
+		expired := 0
+		for expired < len(window) && !window[expired].Date.After(from) {
+			expired++
+		}
+
+		window = window[expired:]
+
+		values := make([]T, len(window))
+		for i, w := range window {
+			values[i] = w.Value
+		}
+
+		return NewDated(value.Date, values)
+	})
+}
+
+// TumblingWindowWithContext groups the consecutive values of the given dated
+// series that fall into the same time bucket, such as the same month, and
+// emits each group with its bucket start once the bucket is complete. The
+// dates are expected to be in ascending order, supporting context cancellation.
+//
+// Example:
+//
+//	months := helper.TumblingWindowWithContext(ctx, returns, helper.MonthBucket)
+//	monthly := helper.AggregateWindowsWithContext(ctx, months, helper.AggregateCompound[float64])
+func TumblingWindowWithContext[T any](ctx context.Context, c <-chan Dated[T], bucket TimeBucket) <-chan Dated[[]T] {
+	return groupDatedWithContext(ctx, c, func(current, value Dated[T]) (time.Time, bool) {
+		start := bucket(value.Date)
+		return start, start.Equal(bucket(current.Date))
+	})
+}
+
+// SessionWindowWithContext groups the consecutive values of the given dated
+// series into sessions that end when the time between two values is longer
+// than the given gap, such as the overnight break between the trading days,
+// and emits each session with its first date once the session is complete.
+// The dates are expected to be in ascending order, supporting context
+// cancellation.
+func SessionWindowWithContext[T any](ctx context.Context, c <-chan Dated[T], gap time.Duration) <-chan Dated[[]T] {
+	return groupDatedWithContext(ctx, c, func(current, value Dated[T]) (time.Time, bool) {
+		return value.Date, value.Date.Sub(current.Date) <= gap
+	})
+}
+
+// AnchoredWindowWithContext emits for each value of the given dated series
+// the values from the start of its time bucket up to and including it, along
+// with its date, so that the window grows within each bucket and restarts at
+// the next one. It is useful for the values that reset at each session, such
+// as the session VWAP. The dates are expected to be in ascending order,
+// supporting context cancellation.
+//
+// Each emitted window is a copy of the values so far in its bucket, so a
+// bucket of n values costs O(n²) time and memory in total. The aggregations
+// that can be updated one value at a time should use the
+// AnchoredAccumulateWithContext instead.
+func AnchoredWindowWithContext[T any](ctx context.Context, c <-chan Dated[T], bucket TimeBucket) <-chan Dated[[]T] {
+	var window []T
+	var start time.Time
+
+	return MapWithContext(ctx, c, func(value Dated[T]) Dated[[]T] {
+		if current := bucket(value.Date); window == nil || !current.Equal(start) {
+			window = window[:0]
+			start = current
+		}
+
+		window = append(window, value.Value)
+
+		return NewDated(value.Date, slices.Clone(window))
+	})
+}
+
+// AnchoredAccumulateWithContext folds the values of the given dated series
+// from the start of their time bucket using the given function, and emits for
+// each value the accumulated state up to and including it, along with its
+// date. The state restarts from its zero value at each bucket, so that the
+// values that reset at each session, such as the session VWAP, are computed
+// in constant time for each value. The dates are expected to be in ascending
+// order, supporting context cancellation.
+//
+// Example:
+//
+//	sums := helper.AnchoredAccumulateWithContext(ctx, volumes, helper.DayBucket, func(sum, volume float64) float64 {
+//		return sum + volume
+//	})
+func AnchoredAccumulateWithContext[T, S any](ctx context.Context, c <-chan Dated[T], bucket TimeBucket, f func(S, T) S) <-chan Dated[S] {
+	var state S
+	var start time.Time
+	first := true
+
+	return MapWithContext(ctx, c, func(value Dated[T]) Dated[S] {
+		if current := bucket(value.Date); first || !current.Equal(start) {
+			var zero S
+			state = zero
+			start = current
+			first = false
+		}
+
+		state = f(state, value.Value)
+
+		return NewDated(value.Date, state)
+	})
+}
+
+// AggregateWindowsWithContext applies the given aggregation function, such as
+// AggregateMean, to the values of each window of the given dated series,
+// supporting context cancellation.
+func AggregateWindowsWithContext[T, R any](ctx context.Context, c <-chan Dated[[]T], f func([]T) R) <-chan Dated[R] {
+	return MapWithContext(ctx, c, func(window Dated[[]T]) Dated[R] {
+		return NewDated(window.Date, f(window.Value))
+	})
+}
+
+// AggregateCount returns the number of values.
+func AggregateCount[T any](values []T) int {
+	return len(values)
+}
+
+// AggregateFirst returns the first value, or zero if there are no values.
+func AggregateFirst[T any](values []T) T {
+	var first T
+	if len(values) > 0 {
+		first = values[0]
+	}
+
+	return first
+}
+
+// AggregateLast returns the last value, or zero if there are no values.
+func AggregateLast[T any](values []T) T {
+	var last T
+	if len(values) > 0 {
+		last = values[len(values)-1]
+	}
+
+	return last
+}
+
+// AggregateSum returns the sum of the values.
+func AggregateSum[T Number](values []T) T {
+	sum := T(0)
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum
+}
+
+// AggregateMean returns the mean of the values, or zero if there are no values.
+func AggregateMean[T Number](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return AggregateSum(values) / T(len(values))
+}
+
+// AggregateMin returns the lowest value, or zero if there are no values.
+func AggregateMin[T Number](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return slices.Min(values)
+}
+
+// AggregateMax returns the highest value, or zero if there are no values.
+func AggregateMax[T Number](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return slices.Max(values)
+}
+
+// AggregateCompound returns the compounded return of the given periodic
+// returns, such as the monthly return of the daily returns.
+func AggregateCompound[T Float](values []T) T {
+	compound := T(1)
+	for _, value := range values {
+		compound *= 1 + value
+	}
+
+	return compound - 1
+}
+
+// groupDatedWithContext groups the consecutive values of the given dated
+// series for which the given function returns true when called with the
+// previous value and the next value. The function also
+// returns the date of the group that the next value starts, if it does not
+// belong to the current group.
+func groupDatedWithContext[T any](ctx context.Context, c <-chan Dated[T], same func(Dated[T], Dated[T]) (time.Time, bool)) <-chan Dated[[]T] {
+	result := make(chan Dated[[]T])
+
+	go func() {
+		defer close(result)
+
+		var group Dated[[]T]
+		var last Dated[T]
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case value, ok := <-c:
+				if !ok {
+					if len(group.Value) > 0 {
+						select {
+						case <-ctx.Done():
+						case result <- group:
+						}
+					}
+
+					return
+				}
+
+				date, ok := same(last, value)
+				if len(group.Value) == 0 || !ok {
+					if len(group.Value) > 0 {
+						select {
+						case <-ctx.Done():
+							return
+						case result <- group:
+						}
+					}
+
+					group = NewDated(date, []T(nil))
+				}
+
+				group.Value = append(group.Value, value.Value)
+				last = value
+			}
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// datedValues returns a dated series with the given dates and values.
+func datedValues(dates []time.Time, values []float64) <-chan helper.Dated[float64] {
+	result := make([]helper.Dated[float64], len(dates))
+	for i := range dates {
+		result[i] = helper.NewDated(dates[i], values[i])
+	}
+
+	return helper.SliceToChan(result)
+}
+
+func TestTimeBuckets(t *testing.T) {
+	date := time.Date(2024, time.March, 14, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		bucket   helper.TimeBucket
+		expected time.Time
+	}{
+		{helper.DayBucket, time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC)},
+		{helper.WeekBucket, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{helper.MonthBucket, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{helper.YearBucket, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{helper.NewSessionBucket(time.UTC, 18*time.Hour), time.Date(2024, time.March, 13, 18, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		actual := test.bucket(date)
+		if !actual.Equal(test.expected) {
+			t.Fatalf("%d actual %v expected %v", i, actual, test.expected)
+		}
+	}
+
+	// Sunday belongs to the week that started on the previous Monday.
+	actual := helper.WeekBucket(time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC))
+	if !actual.Equal(time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("actual %v expected 2024-03-11", actual)
+	}
+}
+
+func TestSessionBucketAcrossDaylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bucket := helper.NewSessionBucket(newYork, 18*time.Hour)
+
+	tests := []struct {
+		date     time.Time
+		expected time.Time
+	}{
+		{time.Date(2024, time.March, 10, 12, 0, 0, 0, newYork), time.Date(2024, time.March, 9, 18, 0, 0, 0, newYork)},
+		{time.Date(2024, time.March, 10, 18, 30, 0, 0, newYork), time.Date(2024, time.March, 10, 18, 0, 0, 0, newYork)},
+		{time.Date(2024, time.November, 3, 17, 30, 0, 0, newYork), time.Date(2024, time.November, 2, 18, 0, 0, 0, newYork)},
+		{time.Date(2024, time.November, 3, 18, 30, 0, 0, newYork), time.Date(2024, time.November, 3, 18, 0, 0, 0, newYork)},
+	}
+
+	for i, test := range tests {
+		actual := bucket(test.date)
+		if !actual.Equal(test.expected) {
+			t.Fatalf("%d actual %v expected %v", i, actual, test.expected)
+		}
+	}
+}
+
+func TestAnchorBucket(t *testing.T) {
+	bucket := helper.NewAnchorBucket(day(9), day(4))
+
+	tests := []struct {
+		date     time.Time
+		expected time.Time
+	}{
+		{day(1), time.Time{}},
+		{day(4), day(4)},
+		{day(8), day(4)},
+		{day(9), day(9)},
+		{day(20), day(9)},
+	}
+
+	for i, test := range tests {
+		actual := bucket(test.date)
+		if !actual.Equal(test.expected) {
+			t.Fatalf("%d actual %v expected %v", i, actual, test.expected)
+		}
+	}
+}
+
+func TestRollingDurationWindow(t *testing.T) {
+	ctx := context.Background()
+
+	input := datedValues(
+		[]time.Time{day(1), day(2), day(4), day(5), day(9)},
+		[]float64{1, 2, 3, 4, 5},
+	)
+
+	expected := []helper.Dated[float64]{
+		helper.NewDated(day(1), 1.0),
+		helper.NewDated(day(2), 3.0),
+		helper.NewDated(day(4), 5.0),
+		helper.NewDated(day(5), 7.0),
+		helper.NewDated(day(9), 5.0),
+	}
+
+	windows := helper.RollingDurationWindowWithContext(ctx, input, 3*24*time.Hour)
+	actual := helper.ChanToSlice(helper.AggregateWindowsWithContext(ctx, windows, helper.AggregateSum[float64]))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestTumblingWindow(t *testing.T) {
+	ctx := context.Background()
+
+	input := datedValues(
+		[]time.Time{
+			time.Date(2024, time.January, 30, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+		},
+		[]float64{0.1, 0.1, 0.5, -0.5},
+	)
+
+	expected := []helper.Dated[float64]{
+		helper.NewDated(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), 0.21),
+		helper.NewDated(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), 0.5),
+		helper.NewDated(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), -0.5),
+	}
+
+	months := helper.TumblingWindowWithContext(ctx, input, helper.MonthBucket)
+	actual := helper.ChanToSlice(helper.AggregateWindowsWithContext(ctx, months, func(values []float64) float64 {
+		return helper.RoundDigit(helper.AggregateCompound(values), 2)
+	}))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestSessionWindow(t *testing.T) {
+	ctx := context.Background()
+
+	open := time.Date(2024, time.January, 2, 9, 30, 0, 0, time.UTC)
+
+	input := datedValues(
+		[]time.Time{
+			open,
+			open.Add(time.Minute),
+			open.Add(2 * time.Minute),
+			open.Add(24 * time.Hour),
+			open.Add(24*time.Hour + time.Minute),
+		},
+		[]float64{1, 2, 3, 4, 5},
+	)
+
+	expected := []helper.Dated[[]float64]{
+		helper.NewDated(open, []float64{1, 2, 3}),
+		helper.NewDated(open.Add(24*time.Hour), []float64{4, 5}),
+	}
+
+	actual := helper.ChanToSlice(helper.SessionWindowWithContext(ctx, input, time.Hour))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestAnchoredWindow(t *testing.T) {
+	ctx := context.Background()
+
+	input := datedValues(
+		[]time.Time{day(1), day(1).Add(time.Hour), day(2), day(2).Add(time.Hour)},
+		[]float64{1, 2, 3, 4},
+	)
+
+	expected := []helper.Dated[float64]{
+		helper.NewDated(day(1), 1.0),
+		helper.NewDated(day(1).Add(time.Hour), 1.5),
+		helper.NewDated(day(2), 3.0),
+		helper.NewDated(day(2).Add(time.Hour), 3.5),
+	}
+
+	windows := helper.AnchoredWindowWithContext(ctx, input, helper.DayBucket)
+	actual := helper.ChanToSlice(helper.AggregateWindowsWithContext(ctx, windows, helper.AggregateMean[float64]))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestAnchoredAccumulate(t *testing.T) {
+	ctx := context.Background()
+
+	input := datedValues(
+		[]time.Time{day(1), day(1).Add(time.Hour), day(2), day(2).Add(time.Hour)},
+		[]float64{1, 2, 3, 4},
+	)
+
+	expected := []helper.Dated[float64]{
+		helper.NewDated(day(1), 1.0),
+		helper.NewDated(day(1).Add(time.Hour), 3.0),
+		helper.NewDated(day(2), 3.0),
+		helper.NewDated(day(2).Add(time.Hour), 7.0),
+	}
+
+	actual := helper.ChanToSlice(helper.AnchoredAccumulateWithContext(ctx, input, helper.DayBucket, func(sum, value float64) float64 {
+		return sum + value
+	}))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestAggregates(t *testing.T) {
+	values := []float64{3, 1, 2}
+
+	if helper.AggregateCount(values) != 3 ||
+		helper.AggregateFirst(values) != 3 ||
+		helper.AggregateLast(values) != 2 ||
+		helper.AggregateMin(values) != 1 ||
+		helper.AggregateMax(values) != 3 ||
+		helper.AggregateMean(values) != 2 {
+		t.Fatal("unexpected aggregate")
+	}
+
+	if helper.AggregateFirst([]float64{}) != 0 || helper.AggregateMax([]float64{}) != 0 || helper.AggregateMean([]float64{}) != 0 {
+		t.Fatal("unexpected empty aggregate")
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// WindowChunksWithContext returns a channel that emits the chunks of the passed
+// function results within a sliding window of size w over the values of the
+// chunks of the input channel c, supporting context cancellation. It is the
+// chunked counterpart of WindowWithContext, and the function is called with
+// the same arguments.
+func WindowChunksWithContext[T any](ctx context.Context, c <-chan []T, f func([]T, int) T, w int) <-chan []T {
+	if w <= 0 {
+		r := make(chan []T)
+		close(r)
+		return r
+	}
+
+	h := make([]T, w)
+	n, cnt := 0, 0
+
+	return MapChunksWithContext(ctx, c, func(val T) T {
+		h[n] = val
+
+		var out T
+		if cnt < w {
+			cnt++
+			out = f(h[:cnt], 0)
+		} else {
+			out = f(h, (n+1)%w)
+		}
+
+		n = (n + 1) % w
+
+		return out
+	})
+}
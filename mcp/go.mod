@@ -5,7 +5,7 @@ go 1.23
 toolchain go1.23.9
 
 require (
-	github.com/cinar/indicator/v2 v2.1.12
+	github.com/cinar/indicator/v2 v2.1.13
 	github.com/mark3labs/mcp-go v0.31.0
 )
 
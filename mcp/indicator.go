@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/mark3labs/mcp-go/mcp"
+
+	// Register the indicators of the indicator packages.
+	_ "github.com/cinar/indicator/v2/momentum"
+	_ "github.com/cinar/indicator/v2/trend"
+	_ "github.com/cinar/indicator/v2/volatility"
+	_ "github.com/cinar/indicator/v2/volume"
+)
+
+// IndicatorRequest defines the structure for an indicator request, including
+// the name of the indicator, its parameters, and its inputs keyed by their names.
+type IndicatorRequest struct {
+	Indicator  string               `json:"indicator"`
+	Parameters map[string]float64   `json:"parameters"`
+	Inputs     map[string][]float64 `json:"inputs"`
+}
+
+// GetAllIndicatorNames returns a slice of the names of all indicators in the
+// indicator registry.
+func GetAllIndicatorNames() []string {
+	descriptors := helper.IndicatorDescriptors()
+
+	names := make([]string, len(descriptors))
+	for i, descriptor := range descriptors {
+		names[i] = descriptor.Name
+	}
+
+	return names
+}
+
+// newIndicatorTool returns the tool that computes an indicator from the
+// indicator registry.
+func newIndicatorTool() mcp.Tool {
+	return mcp.NewTool("indicator",
+		mcp.WithDescription("Compute the specified indicator over the given inputs. Use list_indicators to get the parameters, inputs, and outputs of the indicators."),
+		mcp.WithString("indicator",
+			mcp.Required(),
+			mcp.Description("The indicator to compute"),
+			mcp.Enum(GetAllIndicatorNames()...),
+		),
+		mcp.WithObject("parameters",
+			mcp.Description("Parameters of the indicator keyed by their names. The missing ones get their default values."),
+			mcp.AdditionalProperties(map[string]any{"type": "number"}),
+		),
+		mcp.WithObject("inputs",
+			mcp.Required(),
+			mcp.Description("Inputs of the indicator keyed by their names, such as closing, high, low, and volume"),
+			mcp.AdditionalProperties(map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "number"},
+			}),
+		),
+	)
+}
+
+// newListIndicatorsTool returns the tool that lists the indicators in the
+// indicator registry.
+func newListIndicatorsTool() mcp.Tool {
+	return mcp.NewTool("list_indicators",
+		mcp.WithDescription("List the available indicators along with their parameters, inputs, and outputs"),
+	)
+}
+
+// handleListIndicators returns the descriptors of all indicators in the
+// indicator registry as a JSON string.
+func handleListIndicators(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	type indicator struct {
+		Name       string                      `json:"name"`
+		Title      string                      `json:"title"`
+		Category   string                      `json:"category"`
+		Parameters []helper.IndicatorParameter `json:"parameters"`
+		Inputs     []string                    `json:"inputs"`
+		Outputs    []string                    `json:"outputs"`
+	}
+
+	descriptors := helper.IndicatorDescriptors()
+
+	indicators := make([]indicator, len(descriptors))
+	for i, descriptor := range descriptors {
+		indicators[i] = indicator{
+			Name:       descriptor.Name,
+			Title:      descriptor.Title,
+			Category:   descriptor.Category,
+			Parameters: descriptor.Parameters,
+			Inputs:     descriptor.Inputs,
+			Outputs:    descriptor.Outputs,
+		}
+	}
+
+	jsonData, err := json.Marshal(indicators)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// handleIndicator computes the specified indicator over the given inputs, and
+// returns its outputs keyed by their names, along with its idle period, as a
+// JSON string.
+func handleIndicator(ctx context.Context, _ mcp.CallToolRequest, args IndicatorRequest) (*mcp.CallToolResult, error) {
+	descriptor, err := helper.GetIndicatorDescriptor(args.Indicator)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	idlePeriod, err := descriptor.IdlePeriod(args.Parameters)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// The inputs are canceled along with the outputs, as they may not be read to the end.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	inputs := make(map[string]<-chan float64, len(args.Inputs))
+	for name, values := range args.Inputs {
+		inputs[name] = helper.SliceToChanWithContext(ctx, values)
+	}
+
+	outputs, err := descriptor.ComputeWithContext(ctx, args.Parameters, inputs)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// The outputs are read concurrently, as they may be computed from the same duplicated inputs.
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	results := make(map[string][]float64, len(outputs))
+	for name, output := range outputs {
+		wg.Add(1)
+
+		go func(name string, output <-chan float64) {
+			defer wg.Done()
+
+			values := helper.ChanToSlice(output)
+
+			mu.Lock()
+			results[name] = values
+			mu.Unlock()
+		}(name, output)
+	}
+
+	wg.Wait()
+
+	response := struct {
+		IdlePeriod int                  `json:"idlePeriod"`
+		Outputs    map[string][]float64 `json:"outputs"`
+	}{
+		IdlePeriod: idlePeriod,
+		Outputs:    results,
+	}
+
+	jsonData, err := json.Marshal(response)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
@@ -11,7 +11,8 @@ import (
 )
 
 // RunMCPServer starts the MCP server for the backtest functionality.
-// It configures the server with the necessary tools and handlers for running backtests.
+// It configures the server with the necessary tools and handlers for running backtests,
+// and for computing the indicators from the indicator registry.
 func RunMCPServer() *server.MCPServer {
 	// Create a new MCP server
 	s := server.NewMCPServer(
@@ -69,6 +70,10 @@ func RunMCPServer() *server.MCPServer {
 	// Add tool handler using the typed handler
 	s.AddTool(tool, mcp.NewTypedToolHandler(handleBacktest))
 
+	// Add the indicator tools driven by the indicator registry
+	s.AddTool(newIndicatorTool(), mcp.NewTypedToolHandler(handleIndicator))
+	s.AddTool(newListIndicatorsTool(), handleListIndicators)
+
 	return s
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/client"
@@ -26,6 +27,8 @@ func TestMCPServer(t *testing.T) {
 	testPing(t, client)
 	testListTools(t, client)
 	testCallTool(t, client)
+	testCallIndicatorTool(t, client)
+	testCallListIndicatorsTool(t, client)
 }
 
 func testInitialize(t *testing.T, client *client.Client) {
@@ -134,3 +137,76 @@ func testCallTool(t *testing.T, client *client.Client) {
 		}
 	})
 }
+
+func testCallIndicatorTool(t *testing.T, client *client.Client) {
+	t.Run("CallIndicatorTool", func(t *testing.T) {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "indicator"
+		request.Params.Arguments = map[string]any{
+			"indicator":  "sma",
+			"parameters": map[string]any{"period": 2},
+			"inputs": map[string]any{
+				"closing": []float64{1, 2, 3, 4},
+			},
+		}
+
+		result, err := client.CallTool(context.Background(), request)
+		if err != nil {
+			t.Fatalf("CallTool failed: %v", err)
+		}
+
+		if result.IsError {
+			t.Fatalf("Expected no error")
+		}
+
+		textContent, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			t.Fatalf("Expected text content")
+		}
+
+		var jsonResult struct {
+			IdlePeriod int                  `json:"idlePeriod"`
+			Outputs    map[string][]float64 `json:"outputs"`
+		}
+		if err := json.Unmarshal([]byte(textContent.Text), &jsonResult); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+
+		if jsonResult.IdlePeriod != 1 {
+			t.Errorf("Expected idle period 1, got %d", jsonResult.IdlePeriod)
+		}
+
+		expected := []float64{1.5, 2.5, 3.5}
+		if !reflect.DeepEqual(jsonResult.Outputs["sma"], expected) {
+			t.Errorf("Expected %v, got %v", expected, jsonResult.Outputs["sma"])
+		}
+	})
+}
+
+func testCallListIndicatorsTool(t *testing.T, client *client.Client) {
+	t.Run("CallListIndicatorsTool", func(t *testing.T) {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = "list_indicators"
+
+		result, err := client.CallTool(context.Background(), request)
+		if err != nil {
+			t.Fatalf("CallTool failed: %v", err)
+		}
+
+		textContent, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			t.Fatalf("Expected text content")
+		}
+
+		var indicators []struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal([]byte(textContent.Text), &indicators); err != nil {
+			t.Fatalf("Failed to parse JSON: %v", err)
+		}
+
+		if len(indicators) != len(GetAllIndicatorNames()) {
+			t.Errorf("Expected %d indicators, got %d", len(GetAllIndicatorNames()), len(indicators))
+		}
+	})
+}
@@ -61,6 +61,7 @@ func NewConnorsRsi[T helper.Float]() *ConnorsRsi[T] {
 }
 
 // NewConnorsRsiWithPeriods function initializes a new Connors RSI instance with the given periods.
+// The PercentRank period ranks each value against the previous ones, so it must be at least 2.
 func NewConnorsRsiWithPeriods[T helper.Float](rsiPeriod, streakRsiPeriod, percentRankPeriod int) *ConnorsRsi[T] {
 	if rsiPeriod <= 0 {
 		rsiPeriod = DefaultConnorsRsiRsiPeriod
@@ -68,7 +69,7 @@ func NewConnorsRsiWithPeriods[T helper.Float](rsiPeriod, streakRsiPeriod, percen
 	if streakRsiPeriod <= 0 {
 		streakRsiPeriod = DefaultConnorsRsiStreakRsiPeriod
 	}
-	if percentRankPeriod <= 0 {
+	if percentRankPeriod <= 1 {
 		percentRankPeriod = DefaultConnorsRsiPercentRankPeriod
 	}
 
@@ -93,14 +94,17 @@ func (c *ConnorsRsi[T]) ComputeWithContext(ctx context.Context, closings <-chan
 
 	// Component 1: RSI on closing prices
 	rsis := c.Rsi.ComputeWithContext(ctx, cs[0])
+	rsis = helper.SkipWithContext(ctx, rsis, c.IdlePeriod()-c.Rsi.IdlePeriod())
 
 	// Component 2: RSI on streak length
 	streaks := c.Streak.ComputeWithContext(ctx, cs[1])
 	streakRsis := c.StreakRsi.ComputeWithContext(ctx, streaks)
+	streakRsis = helper.SkipWithContext(ctx, streakRsis, c.IdlePeriod()-c.Streak.IdlePeriod()-c.StreakRsi.IdlePeriod())
 
 	// Component 3: PercentRank of ROC
 	rocs := c.Roc.ComputeWithContext(ctx, cs[2])
 	percentRanks := helper.PercentRankWithContext(ctx, rocs, c.PercentRankPeriod)
+	percentRanks = helper.SkipWithContext(ctx, percentRanks, c.IdlePeriod()-c.Roc.IdlePeriod()-c.PercentRankPeriod)
 
 	// Combine: average of three components
 	result := helper.MultiplyByWithContext(ctx, helper.AddWithContext(ctx, helper.AddWithContext(ctx, rsis, streakRsis),
@@ -114,9 +118,12 @@ func (c *ConnorsRsi[T]) ComputeWithContext(ctx context.Context, closings <-chan
 
 // IdlePeriod is the initial period that Connors RSI won't yield any results.
 func (c *ConnorsRsi[T]) IdlePeriod() int {
-	// ROC period 1 + RSI period 3 + RMA period 14 + PercentRank period 100
-	// = 1 + 3 + 14 + 100 = 118
-	return c.Roc.IdlePeriod() + c.Rsi.IdlePeriod() + c.PercentRankPeriod
+	// The components are aligned to the slowest one.
+	return max(
+		c.Rsi.IdlePeriod(),
+		c.Streak.IdlePeriod()+c.StreakRsi.IdlePeriod(),
+		c.Roc.IdlePeriod()+c.PercentRankPeriod,
+	)
 }
 
 // String is the string representation of the Connors RSI.
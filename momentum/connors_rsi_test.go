@@ -5,6 +5,7 @@
 package momentum_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -38,6 +39,39 @@ func TestConnorsRsi(t *testing.T) {
 	}
 }
 
+func TestConnorsRsiIdlePeriod(t *testing.T) {
+	const count = 400
+
+	tests := []struct {
+		rsiPeriod         int
+		streakRsiPeriod   int
+		percentRankPeriod int
+		idlePeriod        int
+	}{
+		{rsiPeriod: 3, streakRsiPeriod: 2, percentRankPeriod: 100, idlePeriod: 101},
+		{rsiPeriod: 200, streakRsiPeriod: 2, percentRankPeriod: 100, idlePeriod: 200},
+		{rsiPeriod: 3, streakRsiPeriod: 150, percentRankPeriod: 100, idlePeriod: 151},
+	}
+
+	for _, test := range tests {
+		closings := make([]float64, count)
+		for i := range closings {
+			closings[i] = 100 + 10*math.Sin(float64(i)/10) + float64(i%7)
+		}
+
+		connorsRsi := momentum.NewConnorsRsiWithPeriods[float64](test.rsiPeriod, test.streakRsiPeriod, test.percentRankPeriod)
+
+		if connorsRsi.IdlePeriod() != test.idlePeriod {
+			t.Fatalf("%s actual %v expected %v", connorsRsi, connorsRsi.IdlePeriod(), test.idlePeriod)
+		}
+
+		actual := len(helper.ChanToSlice(connorsRsi.Compute(helper.SliceToChan(closings))))
+		if actual != count-test.idlePeriod {
+			t.Fatalf("%s actual %v values expected %v", connorsRsi, actual, count-test.idlePeriod)
+		}
+	}
+}
+
 func TestConnorsRsiString(t *testing.T) {
 	connorsRsi := momentum.NewConnorsRsi[float64]()
 	expected := "ConnorsRSI(3, 2, 100)"
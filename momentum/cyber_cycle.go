@@ -0,0 +1,128 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultCyberCycleAlpha is the default Cyber Cycle alpha of 0.07.
+	DefaultCyberCycleAlpha = 0.07
+)
+
+// CyberCycle represents the configuration parameters for calculating the
+// Ehlers' Cyber Cycle. It isolates the cycle component of the values by
+// applying a two pole high pass filter over the smoothed values. The trigger
+// is the cycle delayed by one period, and the crossovers of the two lines
+// mark the turning points of the cycle.
+//
+//	Smooth = (Value + 2 * Previous Value + 2 * Second Previous Value + Third Previous Value) / 6
+//	Cycle = (1 - 0.5 * a)^2 * (Smooth - 2 * Previous Smooth + Second Previous Smooth)
+//	    + 2 * (1 - a) * Previous Cycle - (1 - a)^2 * Second Previous Cycle
+//	Trigger = Previous Cycle
+//
+// The first six periods use (Value - 2 * Previous Value + Second Previous Value) / 4
+// as the cycle, and the missing prior values are seeded with the first value.
+//
+// Example:
+//
+//	cyberCycle := momentum.NewCyberCycle[float64]()
+//	cycles, triggers := cyberCycle.Compute(closings)
+type CyberCycle[T helper.Float] struct {
+	// Alpha is the smoothing factor.
+	Alpha T
+}
+
+// NewCyberCycle function initializes a new Cyber Cycle instance with the default parameters.
+func NewCyberCycle[T helper.Float]() *CyberCycle[T] {
+	return NewCyberCycleWithAlpha[T](DefaultCyberCycleAlpha)
+}
+
+// NewCyberCycleWithAlpha function initializes a new Cyber Cycle instance with the given alpha.
+func NewCyberCycleWithAlpha[T helper.Float](alpha T) *CyberCycle[T] {
+	return &CyberCycle[T]{
+		Alpha: alpha,
+	}
+}
+
+// cyberCycleResult is the cycle and the trigger of a period.
+type cyberCycleResult[T helper.Float] struct {
+	Cycle   T
+	Trigger T
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the cycle and the trigger.
+func (c *CyberCycle[T]) ComputeWithContext(ctx context.Context, values <-chan T) (<-chan T, <-chan T) {
+	a := c.Alpha
+
+	count := 0
+	var previousValues [3]T
+	var smooths, cycles [2]T
+
+	results := helper.MapWithContext(ctx, values, func(value T) cyberCycleResult[T] {
+		count++
+
+		if count == 1 {
+			previousValues = [3]T{value, value, value}
+		}
+
+		smooth := (value + 2*previousValues[0] + 2*previousValues[1] + previousValues[2]) / 6
+		if count == 1 {
+			smooths = [2]T{smooth, smooth}
+		}
+
+		var cycle T
+		if count < 7 {
+			cycle = (value - 2*previousValues[0] + previousValues[1]) / 4
+		} else {
+			cycle = (1-0.5*a)*(1-0.5*a)*(smooth-2*smooths[0]+smooths[1]) +
+				2*(1-a)*cycles[0] - (1-a)*(1-a)*cycles[1]
+		}
+
+		result := cyberCycleResult[T]{
+			Cycle:   cycle,
+			Trigger: cycles[0],
+		}
+
+		previousValues = [3]T{value, previousValues[0], previousValues[1]}
+		smooths = [2]T{smooth, smooths[0]}
+		cycles = [2]T{cycle, cycles[0]}
+
+		return result
+	})
+
+	resultsSplice := helper.DuplicateWithContext(ctx, results, 2)
+
+	cyclesResult := helper.MapWithContext(ctx, resultsSplice[0], func(r cyberCycleResult[T]) T {
+		return r.Cycle
+	})
+
+	triggers := helper.MapWithContext(ctx, resultsSplice[1], func(r cyberCycleResult[T]) T {
+		return r.Trigger
+	})
+
+	return cyclesResult, triggers
+}
+
+// IdlePeriod is the initial period that Cyber Cycle won't yield any results.
+func (*CyberCycle[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Cyber Cycle.
+func (c *CyberCycle[T]) String() string {
+	return fmt.Sprintf("CC(%v)", c.Alpha)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (c *CyberCycle[T]) Compute(values <-chan T) (<-chan T, <-chan T) {
+	return c.ComputeWithContext(context.Background(), values)
+}
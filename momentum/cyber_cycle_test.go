@@ -0,0 +1,49 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/momentum"
+)
+
+func TestCyberCycle(t *testing.T) {
+	type Data struct {
+		Close   float64
+		Cycle   float64
+		Trigger float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/cyber_cycle.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 3)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expectedCycle := helper.Map(inputs[1], func(d *Data) float64 { return d.Cycle })
+	expectedTrigger := helper.Map(inputs[2], func(d *Data) float64 { return d.Trigger })
+
+	cyberCycle := momentum.NewCyberCycle[float64]()
+	actualCycle, actualTrigger := cyberCycle.Compute(closing)
+	actualCycle = helper.RoundDigits(actualCycle, 2)
+	actualTrigger = helper.RoundDigits(actualTrigger, 2)
+
+	err = helper.CheckEquals(actualCycle, expectedCycle, actualTrigger, expectedTrigger)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCyberCycleString(t *testing.T) {
+	expected := "CC(0.07)"
+	actual := momentum.NewCyberCycle[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
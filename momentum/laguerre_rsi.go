@@ -0,0 +1,110 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultLaguerreRsiGamma is the default Laguerre RSI gamma of 0.5.
+	DefaultLaguerreRsiGamma = 0.5
+)
+
+// LaguerreRsi represents the configuration parameters for calculating the
+// Ehlers' Laguerre RSI. It computes the RSI over the four element Laguerre
+// filter, which provides a smoother and more responsive RSI with a short
+// amount of data. The gamma controls the damping, where the higher values
+// are smoother and slower.
+//
+//	L0 = (1 - Gamma) * Value + Gamma * Previous L0
+//	L1 = -Gamma * L0 + Previous L0 + Gamma * Previous L1
+//	L2 = -Gamma * L1 + Previous L1 + Gamma * Previous L2
+//	L3 = -Gamma * L2 + Previous L2 + Gamma * Previous L3
+//	CU = Sum of positive (L0 - L1), (L1 - L2), (L2 - L3)
+//	CD = Sum of negative (L0 - L1), (L1 - L2), (L2 - L3) as positive
+//	Laguerre RSI = 100 * CU / (CU + CD)
+//
+// The filter elements start with the first value, and the Laguerre RSI keeps
+// its previous value while CU + CD is zero.
+//
+// Example:
+//
+//	laguerreRsi := momentum.NewLaguerreRsi[float64]()
+//	result := laguerreRsi.Compute(closings)
+type LaguerreRsi[T helper.Float] struct {
+	// Gamma is the damping factor between 0 and 1.
+	Gamma T
+}
+
+// NewLaguerreRsi function initializes a new Laguerre RSI instance with the default parameters.
+func NewLaguerreRsi[T helper.Float]() *LaguerreRsi[T] {
+	return NewLaguerreRsiWithGamma[T](DefaultLaguerreRsiGamma)
+}
+
+// NewLaguerreRsiWithGamma function initializes a new Laguerre RSI instance with the given gamma.
+func NewLaguerreRsiWithGamma[T helper.Float](gamma T) *LaguerreRsi[T] {
+	return &LaguerreRsi[T]{
+		Gamma: gamma,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the Laguerre RSI.
+func (l *LaguerreRsi[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	gamma := l.Gamma
+
+	count := 0
+	var l0, l1, l2, l3, rsi T
+
+	return helper.MapWithContext(ctx, c, func(value T) T {
+		count++
+
+		if count == 1 {
+			l0, l1, l2, l3 = value, value, value, value
+		}
+
+		previousL0, previousL1, previousL2 := l0, l1, l2
+
+		l0 = (1-gamma)*value + gamma*l0
+		l1 = -gamma*l0 + previousL0 + gamma*l1
+		l2 = -gamma*l1 + previousL1 + gamma*l2
+		l3 = -gamma*l2 + previousL2 + gamma*l3
+
+		var cu, cd T
+		for _, diff := range []T{l0 - l1, l1 - l2, l2 - l3} {
+			if diff >= 0 {
+				cu += diff
+			} else {
+				cd -= diff
+			}
+		}
+
+		if cu+cd != 0 {
+			rsi = 100 * cu / (cu + cd)
+		}
+
+		return rsi
+	})
+}
+
+// IdlePeriod is the initial period that Laguerre RSI won't yield any results.
+func (*LaguerreRsi[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Laguerre RSI.
+func (l *LaguerreRsi[T]) String() string {
+	return fmt.Sprintf("LRSI(%v)", l.Gamma)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (l *LaguerreRsi[T]) Compute(c <-chan T) <-chan T {
+	return l.ComputeWithContext(context.Background(), c)
+}
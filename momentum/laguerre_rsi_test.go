@@ -0,0 +1,46 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/momentum"
+)
+
+func TestLaguerreRsi(t *testing.T) {
+	type Data struct {
+		Close       float64
+		LaguerreRsi float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/laguerre_rsi.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.LaguerreRsi })
+
+	laguerreRsi := momentum.NewLaguerreRsi[float64]()
+	actual := laguerreRsi.Compute(closing)
+	actual = helper.RoundDigits(actual, 2)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLaguerreRsiString(t *testing.T) {
+	expected := "LRSI(0.5)"
+	actual := momentum.NewLaguerreRsi[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,446 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// category is the category of the indicators in this package.
+const category = "momentum"
+
+// closingsInputs is the inputs of the indicators computed over the closings.
+var closingsInputs = []string{helper.InputClosing}
+
+// barInputs is the inputs of the indicators computed over the highs, lows, and closings.
+var barInputs = []string{helper.InputHigh, helper.InputLow, helper.InputClosing}
+
+func init() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "rsi",
+		Title:      "Relative Strength Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultRsiPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"rsi"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewRsiWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "laguerre_rsi",
+		Title:    "Laguerre RSI",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "gamma",
+				Description: "Damping factor.",
+				Default:     DefaultLaguerreRsiGamma,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"laguerre_rsi"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewLaguerreRsiWithGamma(params["gamma"])
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "cyber_cycle",
+		Title:    "Ehlers Cyber Cycle",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "alpha",
+				Description: "Smoothing factor.",
+				Default:     DefaultCyberCycleAlpha,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"cycle", "trigger"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			cyberCycle := NewCyberCycleWithAlpha(params["alpha"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				cycles, triggers := cyberCycle.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{cycles, triggers}
+			}, cyberCycle.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "stochastic_rsi",
+		Title:      "Stochastic RSI",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultStochasticRsiPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"stochastic_rsi"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewStochasticRsiWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "coppock_curve",
+		Title:    "Coppock Curve",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("rocPeriod1", DefaultCoppockCurveRocPeriod1),
+			helper.NewPeriodParameter("rocPeriod2", DefaultCoppockCurveRocPeriod2),
+			helper.NewPeriodParameter("wmaPeriod", DefaultCoppockCurveWmaPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"coppock_curve"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewCoppockCurveWithPeriods[float64](
+				int(params["rocPeriod1"]),
+				int(params["rocPeriod2"]),
+				int(params["wmaPeriod"]),
+			)
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "awesome_oscillator",
+		Title:    "Awesome Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("shortPeriod", DefaultAwesomeOscillatorShortPeriod),
+			helper.NewPeriodParameter("longPeriod", DefaultAwesomeOscillatorLongPeriod),
+		},
+		Inputs:  []string{helper.InputHigh, helper.InputLow},
+		Outputs: []string{"awesome_oscillator"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ao := NewAwesomeOscillator[float64]()
+			ao.ShortSma.Period = int(params["shortPeriod"])
+			ao.LongSma.Period = int(params["longPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{ao.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, ao.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "elder_ray",
+		Title:      "Elder-Ray Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultElderRayPeriod)},
+		Inputs:     barInputs,
+		Outputs:    []string{"bull_power", "bear_power"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			elderRay := NewElderRayWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				bullPower, bearPower := elderRay.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{bullPower, bearPower}
+			}, elderRay.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "internal_bar_strength",
+		Title:    "Internal Bar Strength",
+		Category: category,
+		Inputs:   barInputs,
+		Outputs:  []string{"internal_bar_strength"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ibs := NewInternalBarStrength[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{ibs.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, ibs.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "qstick",
+		Title:      "Qstick",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultQstickPeriod)},
+		Inputs:     []string{helper.InputOpening, helper.InputClosing},
+		Outputs:    []string{"qstick"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			qstick := NewQstick[float64]()
+			qstick.Sma.Period = int(params["period"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{qstick.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, qstick.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "ultimate_oscillator",
+		Title:    "Ultimate Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("shortPeriod", DefaultUltimateOscillatorShortPeriod),
+			helper.NewPeriodParameter("mediumPeriod", DefaultUltimateOscillatorMediumPeriod),
+			helper.NewPeriodParameter("longPeriod", DefaultUltimateOscillatorLongPeriod),
+		},
+		Inputs:  barInputs,
+		Outputs: []string{"ultimate_oscillator"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			uo := NewUltimateOscillatorWithPeriods[float64](
+				int(params["shortPeriod"]),
+				int(params["mediumPeriod"]),
+				int(params["longPeriod"]),
+			)
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{uo.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, uo.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "williams_r",
+		Title:      "Williams %R",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultWilliamsRPeriod)},
+		Inputs:     barInputs,
+		Outputs:    []string{"williams_r"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			williamsR := NewWilliamsR[float64]()
+			williamsR.Max.Period = int(params["period"])
+			williamsR.Min.Period = int(params["period"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{williamsR.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, williamsR.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "ppo",
+		Title:    "Percentage Price Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("shortPeriod", DefaultPpoShortPeriod),
+			helper.NewPeriodParameter("longPeriod", DefaultPpoLongPeriod),
+			helper.NewPeriodParameter("signalPeriod", DefaultPpoSignalPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"ppo", "signal", "histogram"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ppo := NewPpo[float64]()
+			ppo.ShortEma.Period = int(params["shortPeriod"])
+			ppo.LongEma.Period = int(params["longPeriod"])
+			ppo.SignalEma.Period = int(params["signalPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ppos, signals, histograms := ppo.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{ppos, signals, histograms}
+			}, ppo.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "chaikin_oscillator",
+		Title:    "Chaikin Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("shortPeriod", DefaultChaikinOscillatorShortPeriod),
+			helper.NewPeriodParameter("longPeriod", DefaultChaikinOscillatorLongPeriod),
+		},
+		Inputs:  []string{helper.InputHigh, helper.InputLow, helper.InputClosing, helper.InputVolume},
+		Outputs: []string{"chaikin_oscillator", "ad"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			co := NewChaikinOscillator[float64]()
+			co.ShortEma.Period = int(params["shortPeriod"])
+			co.LongEma.Period = int(params["longPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				cos, ads := co.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])
+				return []<-chan float64{cos, ads}
+			}, co.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "connors_rsi",
+		Title:    "Connors RSI",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("rsiPeriod", DefaultConnorsRsiRsiPeriod),
+			helper.NewPeriodParameter("streakRsiPeriod", DefaultConnorsRsiStreakRsiPeriod),
+			helper.NewPeriodParameterWithMin("percentRankPeriod", DefaultConnorsRsiPercentRankPeriod, 2),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"connors_rsi"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewConnorsRsiWithPeriods[float64](
+				int(params["rsiPeriod"]),
+				int(params["streakRsiPeriod"]),
+				int(params["percentRankPeriod"]),
+			)
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "fisher",
+		Title:      "Fisher Transform",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultFisherPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"fisher"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			period := int(params["period"])
+
+			fisher := NewFisher[float64]()
+			fisher.Period = period
+			fisher.Max.Period = period
+			fisher.Min.Period = period
+
+			return fisher
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "ichimoku_cloud",
+		Title:    "Ichimoku Cloud",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("conversionPeriod", DefaultIchimokuCloudConversionPeriod),
+			helper.NewPeriodParameter("basePeriod", DefaultIchimokuCloudBasePeriod),
+			helper.NewPeriodParameter("leadingPeriod", DefaultIchimokuCloudLeadingPeriod),
+		},
+		Inputs: barInputs,
+		// The lagging span is the closings shifted back in time, and it ends
+		// before the other lines, so it is not one of the outputs.
+		Outputs: []string{"conversion_line", "base_line", "leading_span_a", "leading_span_b"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ic := NewIchimokuCloud[float64]()
+			ic.ConversionMax.Period = int(params["conversionPeriod"])
+			ic.ConversionMin.Period = int(params["conversionPeriod"])
+			ic.BaseMax.Period = int(params["basePeriod"])
+			ic.BaseMin.Period = int(params["basePeriod"])
+			ic.LeadingMax.Period = int(params["leadingPeriod"])
+			ic.LeadingMin.Period = int(params["leadingPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingSpan := ic.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				go helper.DrainWithContext(ctx, laggingSpan)
+
+				return []<-chan float64{conversionLine, baseLine, leadingSpanA, leadingSpanB}
+			}, ic.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "prings_special_k",
+		Title:    "Pring's Special K",
+		Category: category,
+		Inputs:   closingsInputs,
+		Outputs:  []string{"prings_special_k"},
+		Builder: helper.NewIndicatorBuilder(func(map[string]float64) helper.Indicator[float64] {
+			return NewPringsSpecialK[float64]()
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "pvo",
+		Title:    "Percentage Volume Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("shortPeriod", DefaultPvoShortPeriod),
+			helper.NewPeriodParameter("longPeriod", DefaultPvoLongPeriod),
+			helper.NewPeriodParameter("signalPeriod", DefaultPvoSignalPeriod),
+		},
+		Inputs:  []string{helper.InputVolume},
+		Outputs: []string{"pvo", "signal", "histogram"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			pvo := NewPvo[float64]()
+			pvo.ShortEma.Period = int(params["shortPeriod"])
+			pvo.LongEma.Period = int(params["longPeriod"])
+			pvo.SignalEma.Period = int(params["signalPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				pvos, signals, histograms := pvo.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{pvos, signals, histograms}
+			}, pvo.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "rvi",
+		Title:    "Relative Vigor Index",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultRviPeriod),
+			helper.NewPeriodParameter("signalPeriod", DefaultRviSignalPeriod),
+		},
+		Inputs:  []string{helper.InputOpening, helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs: []string{"rvi", "signal"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			rvi := NewRvi[float64]()
+			rvi.Period = int(params["period"])
+			rvi.SignalPeriod = int(params["signalPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				rvis, signals := rvi.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])
+				return []<-chan float64{rvis, signals}
+			}, rvi.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "stochastic_oscillator",
+		Title:    "Stochastic Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultStochasticOscillatorMaxAndMinPeriod),
+			helper.NewPeriodParameter("smaPeriod", DefaultStochasticOscillatorPeriod),
+		},
+		Inputs:  barInputs,
+		Outputs: []string{"k", "d"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			so := NewStochasticOscillator[float64]()
+			so.Max.Period = int(params["period"])
+			so.Min.Period = int(params["period"])
+			so.Sma.Period = int(params["smaPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ks, ds := so.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{ks, ds}
+			}, so.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "td_sequential",
+		Title:    "TD Sequential",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("lookback", DefaultTdSequentialLookback),
+			helper.NewPeriodParameter("countdownLookback", DefaultTdSequentialCountdownLookback),
+			helper.NewPeriodParameter("setupPeriod", DefaultTdSequentialSetupPeriod),
+			helper.NewPeriodParameter("countdownPeriod", DefaultTdSequentialCountdownPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"buy_setup", "sell_setup", "buy_countdown", "sell_countdown"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			td := NewTdSequential[float64]()
+			td.Lookback = int(params["lookback"])
+			td.CountdownLookback = int(params["countdownLookback"])
+			td.SetupPeriod = int(params["setupPeriod"])
+			td.CountdownPeriod = int(params["countdownPeriod"])
+
+			// TD Sequential emits a count for every closing, starting at zero.
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				buySetups, sellSetups, buyCountdowns, sellCountdowns := td.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{buySetups, sellSetups, buyCountdowns, sellCountdowns}
+			}, 0
+		},
+	})
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"context"
+	"math"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultDojiBodyRatio is the default maximum body of a Doji as a ratio of its range.
+	DefaultDojiBodyRatio = 0.1
+
+	// DefaultSmallBodyRatio is the default maximum small body as a ratio of its range.
+	DefaultSmallBodyRatio = 0.3
+
+	// DefaultLongBodyRatio is the default minimum long body as a ratio of its range.
+	DefaultLongBodyRatio = 0.6
+
+	// DefaultLongShadowRatio is the default minimum long shadow as a ratio of its body.
+	DefaultLongShadowRatio = 2
+
+	// DefaultShortShadowRatio is the default maximum short shadow as a ratio of its range.
+	DefaultShortShadowRatio = 0.1
+
+	// DefaultTrendPeriod is the default number of periods that the prior trend is measured over.
+	DefaultTrendPeriod = 5
+)
+
+// Candlestick represents the configuration parameters for recognizing the
+// candlestick patterns. The body is the distance between the opening and the
+// closing, the range is the distance between the high and the low, and the
+// shadows are the distances from the body to the high and to the low.
+//
+// The Hammer, the Hanging Man, the Inverted Hammer, and the Shooting Star
+// have the same shapes, and are told apart by the prior trend, which is up
+// when the closing before the candle is higher than the closing the trend
+// period before it, and down when it is lower.
+//
+// Example:
+//
+//	candlestick := pattern.NewCandlestick()
+//	patterns := candlestick.ComputeWithContext(ctx, snapshots)
+type Candlestick struct {
+	// DojiBodyRatio is the maximum body of a Doji as a ratio of its range.
+	DojiBodyRatio float64
+
+	// SmallBodyRatio is the maximum small body as a ratio of its range.
+	SmallBodyRatio float64
+
+	// LongBodyRatio is the minimum long body as a ratio of its range.
+	LongBodyRatio float64
+
+	// LongShadowRatio is the minimum long shadow as a ratio of its body.
+	LongShadowRatio float64
+
+	// ShortShadowRatio is the maximum short shadow as a ratio of its range.
+	ShortShadowRatio float64
+
+	// TrendPeriod is the number of periods that the prior trend is measured over.
+	TrendPeriod int
+}
+
+// NewCandlestick function initializes a new candlestick pattern recognizer
+// instance with the default thresholds.
+func NewCandlestick() *Candlestick {
+	return &Candlestick{
+		DojiBodyRatio:    DefaultDojiBodyRatio,
+		SmallBodyRatio:   DefaultSmallBodyRatio,
+		LongBodyRatio:    DefaultLongBodyRatio,
+		LongShadowRatio:  DefaultLongShadowRatio,
+		ShortShadowRatio: DefaultShortShadowRatio,
+		TrendPeriod:      DefaultTrendPeriod,
+	}
+}
+
+// candle is the measures of a snapshot.
+type candle struct {
+	*asset.Snapshot
+	Body  float64
+	Range float64
+	Upper float64
+	Lower float64
+}
+
+// newCandle returns the measures of the given snapshot.
+func newCandle(snapshot *asset.Snapshot) candle {
+	return candle{
+		Snapshot: snapshot,
+		Body:     math.Abs(snapshot.Close - snapshot.Open),
+		Range:    snapshot.High - snapshot.Low,
+		Upper:    snapshot.High - max(snapshot.Open, snapshot.Close),
+		Lower:    min(snapshot.Open, snapshot.Close) - snapshot.Low,
+	}
+}
+
+// isBullish checks if the candle closed above its opening.
+func (c candle) isBullish() bool {
+	return c.Close > c.Open
+}
+
+// isBearish checks if the candle closed below its opening.
+func (c candle) isBearish() bool {
+	return c.Close < c.Open
+}
+
+// middle returns the middle of the body.
+func (c candle) middle() float64 {
+	return (c.Open + c.Close) / 2
+}
+
+// ComputeWithContext function takes a channel of snapshots, and returns a
+// channel of the patterns that each snapshot completes, aligned with the
+// snapshots. The multi-candle patterns are reported at their last candle,
+// and a snapshot without any pattern has an empty set.
+func (c *Candlestick) ComputeWithContext(ctx context.Context, snapshots <-chan *asset.Snapshot) <-chan Pattern {
+	size := max(2, c.TrendPeriod+1)
+	previous := make([]candle, 0, size)
+
+	return helper.MapWithContext(ctx, snapshots, func(snapshot *asset.Snapshot) Pattern {
+		current := newCandle(snapshot)
+		patterns := c.single(current, previous) | c.double(current, previous) | c.triple(current, previous)
+
+		if len(previous) == size {
+			previous = append(previous[:0], previous[1:]...)
+		}
+
+		previous = append(previous, current)
+
+		return patterns
+	})
+}
+
+// single returns the single candle patterns of the given candle.
+func (c *Candlestick) single(current candle, previous []candle) Pattern {
+	var patterns Pattern
+
+	if current.Range <= 0 {
+		return patterns
+	}
+
+	isShortUpper := current.Upper <= c.ShortShadowRatio*current.Range
+	isShortLower := current.Lower <= c.ShortShadowRatio*current.Range
+
+	if current.Body <= c.DojiBodyRatio*current.Range {
+		patterns |= Doji
+
+		if isShortUpper && !isShortLower {
+			patterns |= DragonflyDoji
+		}
+
+		if isShortLower && !isShortUpper {
+			patterns |= GravestoneDoji
+		}
+
+		return patterns
+	}
+
+	if current.Body >= c.LongBodyRatio*current.Range && isShortUpper && isShortLower {
+		if current.isBullish() {
+			patterns |= BullishMarubozu
+		} else {
+			patterns |= BearishMarubozu
+		}
+	}
+
+	isUptrend, isDowntrend := c.priorTrend(previous)
+
+	if current.Lower >= c.LongShadowRatio*current.Body && isShortUpper {
+		if isDowntrend {
+			patterns |= Hammer
+		}
+
+		if isUptrend {
+			patterns |= HangingMan
+		}
+	}
+
+	if current.Upper >= c.LongShadowRatio*current.Body && isShortLower {
+		if isDowntrend {
+			patterns |= InvertedHammer
+		}
+
+		if isUptrend {
+			patterns |= ShootingStar
+		}
+	}
+
+	return patterns
+}
+
+// double returns the two candle patterns ending with the given candle.
+func (c *Candlestick) double(current candle, previous []candle) Pattern {
+	var patterns Pattern
+
+	if len(previous) < 1 {
+		return patterns
+	}
+
+	first := previous[len(previous)-1]
+	isFirstLong := first.Body >= c.LongBodyRatio*first.Range
+
+	if first.isBearish() && current.isBullish() {
+		if current.Open <= first.Close && current.Close >= first.Open && current.Body > first.Body {
+			patterns |= BullishEngulfing
+		}
+
+		if isFirstLong && current.Open >= first.Close && current.Close <= first.Open && current.Body < first.Body {
+			patterns |= BullishHarami
+		}
+
+		if isFirstLong && current.Open < first.Close && current.Close > first.middle() && current.Close < first.Open {
+			patterns |= PiercingLine
+		}
+	}
+
+	if first.isBullish() && current.isBearish() {
+		if current.Open >= first.Close && current.Close <= first.Open && current.Body > first.Body {
+			patterns |= BearishEngulfing
+		}
+
+		if isFirstLong && current.Open <= first.Close && current.Close >= first.Open && current.Body < first.Body {
+			patterns |= BearishHarami
+		}
+
+		if isFirstLong && current.Open > first.Close && current.Close < first.middle() && current.Close > first.Open {
+			patterns |= DarkCloudCover
+		}
+	}
+
+	return patterns
+}
+
+// triple returns the three candle patterns ending with the given candle.
+func (c *Candlestick) triple(current candle, previous []candle) Pattern {
+	var patterns Pattern
+
+	if len(previous) < 2 {
+		return patterns
+	}
+
+	first := previous[len(previous)-2]
+	second := previous[len(previous)-1]
+
+	isLong := func(k candle) bool {
+		return k.Body >= c.LongBodyRatio*k.Range
+	}
+
+	isSecondSmall := second.Body <= c.SmallBodyRatio*second.Range
+
+	if isLong(first) && first.isBearish() && isSecondSmall && max(second.Open, second.Close) <= first.Close &&
+		current.isBullish() && current.Close > first.middle() {
+		patterns |= MorningStar
+	}
+
+	if isLong(first) && first.isBullish() && isSecondSmall && min(second.Open, second.Close) >= first.Close &&
+		current.isBearish() && current.Close < first.middle() {
+		patterns |= EveningStar
+	}
+
+	candles := []candle{first, second, current}
+
+	isSoldiers, isCrows := true, true
+	for i, k := range candles {
+		isSoldiers = isSoldiers && isLong(k) && k.isBullish()
+		isCrows = isCrows && isLong(k) && k.isBearish()
+
+		if i > 0 {
+			before := candles[i-1]
+			isSoldiers = isSoldiers && k.Close > before.Close && k.Open >= before.Open && k.Open <= before.Close
+			isCrows = isCrows && k.Close < before.Close && k.Open <= before.Open && k.Open >= before.Close
+		}
+	}
+
+	if isSoldiers {
+		patterns |= ThreeWhiteSoldiers
+	}
+
+	if isCrows {
+		patterns |= ThreeBlackCrows
+	}
+
+	return patterns
+}
+
+// priorTrend returns whether the prior trend is up or down.
+func (c *Candlestick) priorTrend(previous []candle) (bool, bool) {
+	if c.TrendPeriod <= 0 || len(previous) < c.TrendPeriod+1 {
+		return false, false
+	}
+
+	last := previous[len(previous)-1].Close
+	before := previous[len(previous)-1-c.TrendPeriod].Close
+
+	return last > before, last < before
+}
+
+// String is the string representation of the candlestick pattern recognizer.
+func (*Candlestick) String() string {
+	return "Candlestick"
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (c *Candlestick) Compute(snapshots <-chan *asset.Snapshot) <-chan Pattern {
+	return c.ComputeWithContext(context.Background(), snapshots)
+}
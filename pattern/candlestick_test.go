@@ -0,0 +1,109 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/pattern"
+)
+
+// downtrend is the candles of a downtrend preceding the tested candle.
+var downtrend = [][4]float64{
+	{13.5, 13.6, 12.9, 13},
+	{12.5, 12.6, 11.9, 12},
+	{11.5, 11.6, 10.9, 11},
+}
+
+// uptrend is the candles of an uptrend preceding the tested candle.
+var uptrend = [][4]float64{
+	{11, 11.6, 10.9, 11.5},
+	{12, 12.6, 11.9, 12.5},
+	{13, 13.6, 12.9, 13.5},
+}
+
+// candlesAsSnapshots converts the given opening, high, low, and closing
+// candles into snapshots.
+func candlesAsSnapshots(candles [][4]float64) <-chan *asset.Snapshot {
+	snapshots := make([]*asset.Snapshot, len(candles))
+	for i, c := range candles {
+		snapshots[i] = &asset.Snapshot{
+			Open:  c[0],
+			High:  c[1],
+			Low:   c[2],
+			Close: c[3],
+		}
+	}
+
+	return helper.SliceToChan(snapshots)
+}
+
+func TestCandlestick(t *testing.T) {
+	tests := []struct {
+		name     string
+		candles  [][4]float64
+		expected pattern.Pattern
+	}{
+		{"Doji", [][4]float64{{10, 11, 9, 10.05}}, pattern.Doji},
+		{"Dragonfly Doji", [][4]float64{{10, 10.05, 8, 10}}, pattern.Doji | pattern.DragonflyDoji},
+		{"Gravestone Doji", [][4]float64{{10, 12, 9.98, 10}}, pattern.Doji | pattern.GravestoneDoji},
+		{"Hammer", append(downtrend, [4]float64{10.3, 10.55, 9.5, 10.5}), pattern.Hammer},
+		{"Hanging Man", append(uptrend, [4]float64{13.7, 13.95, 12.9, 13.9}), pattern.HangingMan},
+		{"Inverted Hammer", append(downtrend, [4]float64{10.5, 11.3, 10.25, 10.3}), pattern.InvertedHammer},
+		{"Shooting Star", append(uptrend, [4]float64{13.7, 14.7, 13.65, 13.9}), pattern.ShootingStar},
+		{"Bullish Marubozu", [][4]float64{{10, 11, 10, 11}}, pattern.BullishMarubozu},
+		{"Bearish Marubozu", [][4]float64{{11, 11, 10, 10}}, pattern.BearishMarubozu},
+		{"Bullish Engulfing", [][4]float64{{11, 11.2, 9.8, 10}, {9.9, 11.5, 9.6, 11.2}}, pattern.BullishEngulfing},
+		{"Bearish Engulfing", [][4]float64{{10, 11.2, 9.8, 11}, {11.1, 11.5, 9.6, 9.8}}, pattern.BearishEngulfing},
+		{"Bullish Harami", [][4]float64{{12, 12.1, 9.9, 10}, {10.5, 11.2, 10.3, 11}}, pattern.BullishHarami},
+		{"Bearish Harami", [][4]float64{{10, 12.1, 9.9, 12}, {11.5, 11.7, 10.8, 11}}, pattern.BearishHarami},
+		{"Piercing Line", [][4]float64{{12, 12.1, 9.9, 10}, {9.5, 11.9, 9.3, 11.5}}, pattern.PiercingLine},
+		{"Dark Cloud Cover", [][4]float64{{10, 12.1, 9.9, 12}, {12.5, 12.7, 10.1, 10.5}}, pattern.DarkCloudCover},
+		{"Morning Star", [][4]float64{{12, 12.1, 9.9, 10}, {9.6, 9.8, 9.3, 9.5}, {9.7, 11.9, 9.4, 11.5}}, pattern.MorningStar},
+		{"Evening Star", [][4]float64{{10, 12.1, 9.9, 12}, {12.4, 12.7, 12.2, 12.5}, {12.3, 12.6, 10.1, 10.5}}, pattern.EveningStar},
+		{"Three White Soldiers", [][4]float64{{10, 11.1, 9.9, 11}, {10.5, 12.1, 10.4, 12}, {11.5, 13.1, 11.2, 13}}, pattern.ThreeWhiteSoldiers},
+		{"Three Black Crows", [][4]float64{{13, 13.1, 11.9, 12}, {12.5, 12.6, 10.9, 11}, {11.5, 11.8, 9.9, 10}}, pattern.ThreeBlackCrows},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			candlestick := pattern.NewCandlestick()
+			candlestick.TrendPeriod = 2
+
+			patterns := helper.ChanToSlice(candlestick.Compute(candlesAsSnapshots(test.candles)))
+
+			if len(patterns) != len(test.candles) {
+				t.Fatalf("actual %d patterns expected %d", len(patterns), len(test.candles))
+			}
+
+			actual := patterns[len(patterns)-1]
+			if actual != test.expected {
+				t.Fatalf("actual %q expected %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestCandlestickWithoutTrend(t *testing.T) {
+	candlestick := pattern.NewCandlestick()
+
+	// The prior trend is not known yet for the Hammer shape.
+	patterns := helper.ChanToSlice(candlestick.Compute(candlesAsSnapshots(append(downtrend, [4]float64{10.3, 10.55, 9.5, 10.5}))))
+
+	if patterns[len(patterns)-1] != 0 {
+		t.Fatalf("actual %q expected none", patterns[len(patterns)-1])
+	}
+}
+
+func TestCandlestickString(t *testing.T) {
+	expected := "Candlestick"
+	actual := pattern.NewCandlestick().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
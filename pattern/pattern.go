@@ -0,0 +1,158 @@
+// Package pattern contains the candlestick pattern recognition functions.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2026 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package pattern
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// Pattern is a set of candlestick patterns, where each pattern is a flag.
+// The patterns are either bullish, bearish, or neutral like the Doji.
+type Pattern uint32
+
+const (
+	// Doji is a candle with almost no body, showing indecision.
+	Doji Pattern = 1 << iota
+
+	// DragonflyDoji is a Doji with a long lower shadow and no upper shadow.
+	DragonflyDoji
+
+	// GravestoneDoji is a Doji with a long upper shadow and no lower shadow.
+	GravestoneDoji
+
+	// Hammer is a small body with a long lower shadow after a downtrend.
+	Hammer
+
+	// HangingMan is a small body with a long lower shadow after an uptrend.
+	HangingMan
+
+	// InvertedHammer is a small body with a long upper shadow after a downtrend.
+	InvertedHammer
+
+	// ShootingStar is a small body with a long upper shadow after an uptrend.
+	ShootingStar
+
+	// BullishMarubozu is a long bullish body with almost no shadows.
+	BullishMarubozu
+
+	// BearishMarubozu is a long bearish body with almost no shadows.
+	BearishMarubozu
+
+	// BullishEngulfing is a bullish body that engulfs the previous bearish body.
+	BullishEngulfing
+
+	// BearishEngulfing is a bearish body that engulfs the previous bullish body.
+	BearishEngulfing
+
+	// BullishHarami is a bullish body inside the previous long bearish body.
+	BullishHarami
+
+	// BearishHarami is a bearish body inside the previous long bullish body.
+	BearishHarami
+
+	// PiercingLine is a bullish candle that opens below the previous long
+	// bearish candle, and closes above the middle of its body.
+	PiercingLine
+
+	// DarkCloudCover is a bearish candle that opens above the previous long
+	// bullish candle, and closes below the middle of its body.
+	DarkCloudCover
+
+	// MorningStar is a long bearish candle, a small body below it, and a
+	// bullish candle closing above the middle of the first body.
+	MorningStar
+
+	// EveningStar is a long bullish candle, a small body above it, and a
+	// bearish candle closing below the middle of the first body.
+	EveningStar
+
+	// ThreeWhiteSoldiers is three long bullish candles, each opening within
+	// the previous body and closing higher.
+	ThreeWhiteSoldiers
+
+	// ThreeBlackCrows is three long bearish candles, each opening within the
+	// previous body and closing lower.
+	ThreeBlackCrows
+)
+
+const (
+	// BullishPatterns is the set of the bullish patterns.
+	BullishPatterns = DragonflyDoji | Hammer | InvertedHammer | BullishMarubozu | BullishEngulfing |
+		BullishHarami | PiercingLine | MorningStar | ThreeWhiteSoldiers
+
+	// BearishPatterns is the set of the bearish patterns.
+	BearishPatterns = GravestoneDoji | HangingMan | ShootingStar | BearishMarubozu | BearishEngulfing |
+		BearishHarami | DarkCloudCover | EveningStar | ThreeBlackCrows
+
+	// AllPatterns is the set of all patterns.
+	AllPatterns = Doji | BullishPatterns | BearishPatterns
+)
+
+// patternNames is the names of the patterns in the order of their flags.
+var patternNames = []string{
+	"Doji",
+	"Dragonfly Doji",
+	"Gravestone Doji",
+	"Hammer",
+	"Hanging Man",
+	"Inverted Hammer",
+	"Shooting Star",
+	"Bullish Marubozu",
+	"Bearish Marubozu",
+	"Bullish Engulfing",
+	"Bearish Engulfing",
+	"Bullish Harami",
+	"Bearish Harami",
+	"Piercing Line",
+	"Dark Cloud Cover",
+	"Morning Star",
+	"Evening Star",
+	"Three White Soldiers",
+	"Three Black Crows",
+}
+
+// Has checks if the set has any of the given patterns.
+func (p Pattern) Has(patterns Pattern) bool {
+	return p&patterns != 0
+}
+
+// IsBullish checks if the set has any bullish pattern.
+func (p Pattern) IsBullish() bool {
+	return p.Has(BullishPatterns)
+}
+
+// IsBearish checks if the set has any bearish pattern.
+func (p Pattern) IsBearish() bool {
+	return p.Has(BearishPatterns)
+}
+
+// String is the comma separated names of the patterns in the set.
+func (p Pattern) String() string {
+	var names []string
+
+	for rest := p; rest != 0; rest &= rest - 1 {
+		i := bits.TrailingZeros32(uint32(rest))
+		if i < len(patternNames) {
+			names = append(names, patternNames[i])
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/pattern"
+)
+
+func TestPatternString(t *testing.T) {
+	expected := "Doji, Dragonfly Doji, Three Black Crows"
+	actual := (pattern.ThreeBlackCrows | pattern.Doji | pattern.DragonflyDoji).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestPatternDirection(t *testing.T) {
+	patterns := pattern.Hammer | pattern.Doji
+
+	if !patterns.IsBullish() {
+		t.Fatal("expected bullish")
+	}
+
+	if patterns.IsBearish() {
+		t.Fatal("expected not bearish")
+	}
+
+	if pattern.Doji.IsBullish() || pattern.Doji.IsBearish() {
+		t.Fatal("expected neutral")
+	}
+
+	if !patterns.Has(pattern.Doji | pattern.EveningStar) {
+		t.Fatal("expected to have Doji")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// ComputeOnBarsWithContext runs the given strategy on the bars that the given
+// bar builder builds from the provided snapshots, such as the Renko bricks,
+// and maps the resulting actions back to the snapshots, supporting context
+// cancellation. The action for each snapshot is the last action that is not a
+// hold among the bars that it completed, or a hold if there is none, so that
+// the outcomes can be computed on the original time series.
+//
+// Example:
+//
+//	actions := strategy.ComputeOnBarsWithContext(ctx, macd, asset.NewRenkoBuilder(5), snapshots)
+func ComputeOnBarsWithContext(ctx context.Context, s Strategy, builder asset.BarBuilder, snapshots <-chan *asset.Snapshot) <-chan Action {
+	bars := make(chan *asset.Snapshot)
+	counts := make(chan int)
+
+	// The actions of the strategy are buffered, since it may read ahead of the
+	// bars before yielding the actions for them.
+	barActions := helper.UnboundedWithContext(ctx, ComputeStrategyWithContext(ctx, s, bars))
+
+	// The bars are fed independently of the actions, along with the number of
+	// bars that each snapshot completed.
+	go func() {
+		defer close(counts)
+		defer close(bars)
+
+		for {
+			var snapshot *asset.Snapshot
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case snapshot, ok = <-snapshots:
+				if !ok {
+					return
+				}
+			}
+
+			completed := builder.Add(snapshot)
+
+			for _, bar := range completed {
+				select {
+				case <-ctx.Done():
+					return
+				case bars <- bar:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case counts <- len(completed):
+			}
+		}
+	}()
+
+	pending := helper.UnboundedWithContext(ctx, counts)
+	result := make(chan Action)
+
+	go func() {
+		defer close(result)
+		defer helper.DrainWithContext(ctx, barActions)
+
+		for {
+			var count int
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case count, ok = <-pending:
+				if !ok {
+					return
+				}
+			}
+
+			action := Hold
+
+			for i := 0; i < count; i++ {
+				var barAction Action
+
+				select {
+				case <-ctx.Done():
+					return
+				case barAction, ok = <-barActions:
+					if !ok {
+						return
+					}
+				}
+
+				if barAction != Hold {
+					action = barAction
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case result <- action:
+			}
+		}
+	}()
+
+	return result
+}
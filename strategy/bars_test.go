@@ -0,0 +1,66 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// brickDirectionStrategy buys on the up bricks and sells on the down bricks.
+type brickDirectionStrategy struct{}
+
+func (brickDirectionStrategy) Name() string {
+	return "Brick Direction"
+}
+
+func (brickDirectionStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	return helper.Map(c, func(bar *asset.Snapshot) strategy.Action {
+		if bar.Close > bar.Open {
+			return strategy.Buy
+		}
+
+		return strategy.Sell
+	})
+}
+
+func (brickDirectionStrategy) Report(_ <-chan *asset.Snapshot) *helper.Report {
+	return nil
+}
+
+func TestComputeOnBars(t *testing.T) {
+	closings := []float64{100, 104, 111, 109, 103, 99, 94, 96}
+
+	snapshots := make([]*asset.Snapshot, len(closings))
+	for i, closing := range closings {
+		snapshots[i] = &asset.Snapshot{
+			Date:  time.Date(2024, time.January, i+1, 0, 0, 0, 0, time.UTC),
+			Close: closing,
+		}
+	}
+
+	expected := helper.SliceToChan([]strategy.Action{
+		strategy.Hold,
+		strategy.Hold,
+		strategy.Buy,
+		strategy.Hold,
+		strategy.Hold,
+		strategy.Sell,
+		strategy.Sell,
+		strategy.Hold,
+	})
+
+	actual := strategy.ComputeOnBarsWithContext(context.Background(), brickDirectionStrategy{}, asset.NewRenkoBuilder(5), helper.SliceToChan(snapshots))
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// DecimalOutcomeWithContext simulates the potential result of executing the given actions based on the provided
+// decimal values, supporting context cancellation. Unlike OutcomeWithContext, the balance is kept as a fixed-point
+// decimal, so the result does not accumulate floating-point rounding errors over many trades. The balance is scaled
+// by the ratio of the selling and the buying prices, rather than being converted to shares, so that the precision is
+// kept at high prices.
+func DecimalOutcomeWithContext(ctx context.Context, values <-chan helper.Decimal, actions <-chan Action) <-chan helper.Decimal {
+	one := helper.NewDecimalFromInt(1)
+
+	balance := one
+	var price helper.Decimal
+
+	return helper.OperateWithContext(ctx, values, actions, func(value helper.Decimal, action Action) helper.Decimal {
+		if price.IsZero() && balance.Sign() > 0 && action == Buy {
+			price = value
+		} else if !price.IsZero() && action == Sell {
+			balance = balance.MulDiv(value, price)
+			price = helper.Decimal{}
+		}
+
+		if price.IsZero() {
+			return balance.Sub(one)
+		}
+
+		return balance.MulDiv(value, price).Sub(one)
+	})
+}
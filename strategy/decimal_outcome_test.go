@@ -0,0 +1,71 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+func TestDecimalOutcome(t *testing.T) {
+	values := helper.DecimalsFromFloatsWithContext(context.Background(), helper.SliceToChan([]float64{
+		10, 15, 12, 12, 18,
+		20, 22, 25, 24, 20,
+	}))
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Hold, strategy.Hold, strategy.Buy, strategy.Buy, strategy.Hold,
+		strategy.Hold, strategy.Hold, strategy.Sell, strategy.Hold, strategy.Hold,
+	})
+
+	expected := helper.SliceToChan([]helper.Decimal{
+		helper.MustParseDecimal("0"),
+		helper.MustParseDecimal("0"),
+		helper.MustParseDecimal("0"),
+		helper.MustParseDecimal("0"),
+		helper.MustParseDecimal("0.5"),
+		helper.MustParseDecimal("0.66666667"),
+		helper.MustParseDecimal("0.83333333"),
+		helper.MustParseDecimal("1.08333333"),
+		helper.MustParseDecimal("1.08333333"),
+		helper.MustParseDecimal("1.08333333"),
+	})
+
+	actual := strategy.DecimalOutcomeWithContext(context.Background(), values, actions)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalOutcomeHighPrices(t *testing.T) {
+	values := helper.SliceToChan([]helper.Decimal{
+		helper.MustParseDecimal("612345.67"),
+		helper.MustParseDecimal("650000"),
+		helper.MustParseDecimal("640000"),
+	})
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Buy, strategy.Sell, strategy.Hold,
+	})
+
+	// The float outcome is 0.0614919511066.
+	expected := helper.SliceToChan([]helper.Decimal{
+		helper.MustParseDecimal("0"),
+		helper.MustParseDecimal("0.06149195"),
+		helper.MustParseDecimal("0.06149195"),
+	})
+
+	actual := strategy.DecimalOutcomeWithContext(context.Background(), values, actions)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
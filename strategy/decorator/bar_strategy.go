@@ -0,0 +1,92 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// BarStrategy is a decorator that runs the inner strategy on the bars built
+// from the snapshots, such as the Renko bricks, the Kagi lines, or the
+// Three-Line-Break lines, and maps the resulting actions back to the
+// snapshots, so that the outcomes are computed on the original time series.
+//
+// Example:
+//
+//	renko := decorator.NewBarStrategy("Renko", trend.NewMacdStrategy(), func() asset.BarBuilder {
+//		return asset.NewRenkoBuilder(5)
+//	})
+type BarStrategy struct {
+	// BarName is the name of the bars.
+	BarName string
+
+	// InnerStrategy is the inner strategy.
+	InnerStrategy strategy.Strategy
+
+	// NewBuilder returns a new bar builder for each computation.
+	NewBuilder func() asset.BarBuilder
+}
+
+// NewBarStrategy function initializes a new bar strategy instance with the
+// given bar name, inner strategy, and bar builder factory.
+func NewBarStrategy(barName string, innerStrategy strategy.Strategy, newBuilder func() asset.BarBuilder) *BarStrategy {
+	return &BarStrategy{
+		BarName:       barName,
+		InnerStrategy: innerStrategy,
+		NewBuilder:    newBuilder,
+	}
+}
+
+// Name returns the name of the strategy.
+func (b *BarStrategy) Name() string {
+	return fmt.Sprintf("%s Strategy (%s)", b.BarName, b.InnerStrategy.Name())
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (b *BarStrategy) ComputeWithContext(ctx context.Context, snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return strategy.ComputeOnBarsWithContext(ctx, b.InnerStrategy, b.NewBuilder(), snapshots)
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (b *BarStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := strategy.ComputeWithOutcome(b, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(b.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (b *BarStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return b.ComputeWithContext(context.Background(), snapshots)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/compound"
+	"github.com/cinar/indicator/v2/strategy/decorator"
+	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/pattern"
+	"github.com/cinar/indicator/v2/strategy/trend"
+	"github.com/cinar/indicator/v2/strategy/volatility"
+	"github.com/cinar/indicator/v2/strategy/volume"
+)
+
+func TestBarStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/bar_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	innerStrategy := trend.NewAroonStrategy()
+	barStrategy := decorator.NewBarStrategy("Renko", innerStrategy, func() asset.BarBuilder {
+		return asset.NewRenkoBuilder(2)
+	})
+
+	actual := barStrategy.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBarStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	innerStrategy := trend.NewAroonStrategy()
+	barStrategy := decorator.NewBarStrategy("Renko", innerStrategy, func() asset.BarBuilder {
+		return asset.NewRenkoBuilder(2)
+	})
+
+	report := barStrategy.Report(snapshots)
+
+	fileName := "bar_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBarStrategyAllStrategies(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(snapshots)
+
+	var strategies []strategy.Strategy
+	strategies = append(strategies, compound.AllStrategies()...)
+	strategies = append(strategies, momentum.AllStrategies()...)
+	strategies = append(strategies, pattern.AllStrategies()...)
+	strategies = append(strategies, strategy.AllStrategies()...)
+	strategies = append(strategies, trend.AllStrategies()...)
+	strategies = append(strategies, volatility.AllStrategies()...)
+	strategies = append(strategies, volume.AllStrategies()...)
+
+	for _, innerStrategy := range strategies {
+		t.Run(innerStrategy.Name(), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			barStrategy := decorator.NewBarStrategy("Renko", innerStrategy, func() asset.BarBuilder {
+				return asset.NewRenkoBuilder(2)
+			})
+
+			actions := helper.ChanToSlice(barStrategy.ComputeWithContext(ctx, helper.SliceToChan(rows)))
+
+			if len(actions) != len(rows) {
+				t.Fatalf("actual %v expected %v", len(actions), len(rows))
+			}
+		})
+	}
+}
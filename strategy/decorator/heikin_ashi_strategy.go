@@ -0,0 +1,82 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// HeikinAshiStrategy is a decorator that runs the inner strategy on the
+// Heikin-Ashi candles of the snapshots instead of the snapshots themselves,
+// so that the inner strategy trades on the smoothed prices.
+//
+// Example:
+//
+//	innerStrategy := trend.NewMacdStrategy()
+//	strategy := decorator.NewHeikinAshiStrategy(innerStrategy)
+type HeikinAshiStrategy struct {
+	// InnerStrategy is the inner strategy.
+	InnerStrategy strategy.Strategy
+}
+
+// NewHeikinAshiStrategy function initializes a new Heikin-Ashi strategy instance
+// with the given inner strategy.
+func NewHeikinAshiStrategy(innerStrategy strategy.Strategy) *HeikinAshiStrategy {
+	return &HeikinAshiStrategy{
+		InnerStrategy: innerStrategy,
+	}
+}
+
+// Name returns the name of the strategy.
+func (h *HeikinAshiStrategy) Name() string {
+	return fmt.Sprintf("Heikin-Ashi Strategy (%s)", h.InnerStrategy.Name())
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (h *HeikinAshiStrategy) ComputeWithContext(ctx context.Context, snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return strategy.ComputeStrategyWithContext(ctx, h.InnerStrategy, asset.SnapshotsAsHeikinAshiWithContext(ctx, snapshots))
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (h *HeikinAshiStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := strategy.ComputeWithOutcome(h, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(h.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (h *HeikinAshiStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return h.ComputeWithContext(context.Background(), snapshots)
+}
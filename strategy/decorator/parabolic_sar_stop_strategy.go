@@ -0,0 +1,126 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// ParabolicSarStopStrategy is a decorator that uses the Parabolic SAR as a
+// trailing stop for the positions opened by the inner strategy. The stop is
+// an uptrend Parabolic SAR that starts at the low of the buy period, and then
+// accelerates towards the highest high since the buy. The asset is sold when
+// the inner strategy recommends it, or when the low falls to or below the
+// stop, reversing the Parabolic SAR.
+//
+// Example:
+//
+//	innerStrategy := trend.NewMacdStrategy()
+//	strategy := decorator.NewParabolicSarStopStrategy(innerStrategy)
+type ParabolicSarStopStrategy struct {
+	// InnerStrategy is the inner strategy.
+	InnerStrategy strategy.Strategy
+
+	// Step is the acceleration factor step.
+	Step float64
+
+	// Maximum is the maximum acceleration factor.
+	Maximum float64
+}
+
+// NewParabolicSarStopStrategy function initializes a new Parabolic SAR stop
+// strategy instance with the default acceleration factor step and maximum.
+func NewParabolicSarStopStrategy(innerStrategy strategy.Strategy) *ParabolicSarStopStrategy {
+	return &ParabolicSarStopStrategy{
+		InnerStrategy: innerStrategy,
+		Step:          trend.DefaultParabolicSarStep,
+		Maximum:       trend.DefaultParabolicSarMaximum,
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *ParabolicSarStopStrategy) Name() string {
+	return fmt.Sprintf("Parabolic SAR Stop Strategy (%s)", p.InnerStrategy.Name())
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (p *ParabolicSarStopStrategy) ComputeWithContext(ctx context.Context, snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.DuplicateWithContext(ctx, snapshots, 2)
+
+	innerActions := strategy.ComputeStrategyWithContext(ctx, p.InnerStrategy, snapshotsSplice[0])
+
+	psar := trend.NewParabolicSarWithStepAndMaximum(p.Step, p.Maximum)
+	var stop *trend.ParabolicSarState[float64]
+
+	return helper.OperateWithContext(ctx, innerActions, snapshotsSplice[1], func(action strategy.Action, snapshot *asset.Snapshot) strategy.Action {
+		// If action is Buy and the asset is not yet bought, buy it and start the stop at the low.
+		if stop == nil {
+			if action != strategy.Buy {
+				return strategy.Hold
+			}
+
+			stop = psar.Start(true, snapshot.High, snapshot.Low)
+
+			return strategy.Buy
+		}
+
+		// If asset is bought and action is sell, recommend sell.
+		if action == strategy.Sell {
+			stop = nil
+			return strategy.Sell
+		}
+
+		// If the Parabolic SAR reverses to a downtrend, recommend sell.
+		if _, direction := stop.Next(snapshot.High, snapshot.Low); direction == trend.ParabolicSarDown {
+			stop = nil
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (p *ParabolicSarStopStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (p *ParabolicSarStopStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return p.ComputeWithContext(context.Background(), snapshots)
+}
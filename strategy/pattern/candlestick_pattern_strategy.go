@@ -0,0 +1,182 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/pattern"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultCandlestickPatternStrategyTrendPeriod is the default trend confirmation SMA period of 50.
+	DefaultCandlestickPatternStrategyTrendPeriod = 50
+)
+
+// CandlestickPatternStrategy represents the configuration parameters for
+// calculating the candlestick pattern strategy. A bullish pattern among the
+// selected patterns suggests a buy action, and a bearish pattern suggests a
+// sell action. When both are present, a hold action is recommended.
+//
+// The trend confirmation is optional. When a trend moving average is set, a
+// buy action is only recommended when the closing is above the moving
+// average, and a sell action is only recommended when the closing is below
+// it, so that only the patterns in the direction of the trend are traded.
+//
+// Example:
+//
+//	patterns := pattern.BullishEngulfing | pattern.BearishEngulfing
+//	ma := trend.NewSmaWithPeriod[float64](50)
+//	strategy := pattern.NewCandlestickPatternStrategyWithTrendConfirmation(patterns, ma)
+type CandlestickPatternStrategy struct {
+	// Candlestick is the candlestick pattern recognizer.
+	Candlestick *pattern.Candlestick
+
+	// Patterns is the set of the patterns to trade.
+	Patterns pattern.Pattern
+
+	// TrendMa is the moving average for the trend confirmation, or nil for
+	// no trend confirmation.
+	TrendMa trend.Ma[float64]
+}
+
+// NewCandlestickPatternStrategy function initializes a new candlestick pattern
+// strategy instance that trades all patterns without the trend confirmation.
+func NewCandlestickPatternStrategy() *CandlestickPatternStrategy {
+	return NewCandlestickPatternStrategyWithPatterns(pattern.AllPatterns)
+}
+
+// NewCandlestickPatternStrategyWithPatterns function initializes a new
+// candlestick pattern strategy instance that trades the given patterns
+// without the trend confirmation.
+func NewCandlestickPatternStrategyWithPatterns(patterns pattern.Pattern) *CandlestickPatternStrategy {
+	return NewCandlestickPatternStrategyWithTrendConfirmation(patterns, nil)
+}
+
+// NewCandlestickPatternStrategyWithTrendConfirmation function initializes a
+// new candlestick pattern strategy instance that trades the given patterns
+// confirmed by the given trend moving average.
+func NewCandlestickPatternStrategyWithTrendConfirmation(patterns pattern.Pattern, trendMa trend.Ma[float64]) *CandlestickPatternStrategy {
+	return &CandlestickPatternStrategy{
+		Candlestick: pattern.NewCandlestick(),
+		Patterns:    patterns,
+		TrendMa:     trendMa,
+	}
+}
+
+// Name returns the name of the strategy.
+func (c *CandlestickPatternStrategy) Name() string {
+	if c.TrendMa == nil {
+		return "Candlestick Pattern Strategy"
+	}
+
+	return fmt.Sprintf("Candlestick Pattern Strategy (%s)", c.TrendMa.String())
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (c *CandlestickPatternStrategy) ComputeWithContext(ctx context.Context, snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	if c.TrendMa == nil {
+		return helper.MapWithContext(ctx, c.Candlestick.ComputeWithContext(ctx, snapshots), c.patternsToAction)
+	}
+
+	snapshotsSplice := helper.DuplicateWithContext(ctx, snapshots, 2)
+
+	closings := helper.DuplicateWithContext(ctx, asset.SnapshotsAsClosingsWithContext(ctx, snapshotsSplice[0]), 2)
+	mas := trend.ComputeMaWithContext(ctx, c.TrendMa, closings[0])
+
+	closings[1] = helper.SkipWithContext(ctx, closings[1], c.TrendMa.IdlePeriod())
+	patterns := helper.SkipWithContext(ctx, c.Candlestick.ComputeWithContext(ctx, snapshotsSplice[1]), c.TrendMa.IdlePeriod())
+
+	actions := helper.Operate3WithContext(ctx, patterns, closings[1], mas, func(patterns pattern.Pattern, closing, ma float64) strategy.Action {
+		action := c.patternsToAction(patterns)
+
+		if action == strategy.Buy && closing <= ma {
+			return strategy.Hold
+		}
+
+		if action == strategy.Sell && closing >= ma {
+			return strategy.Hold
+		}
+
+		return action
+	})
+
+	// The trend moving average starts only after its idle period.
+	return helper.ShiftWithContext(ctx, actions, c.TrendMa.IdlePeriod(), strategy.Hold)
+}
+
+// patternsToAction returns the action for the selected patterns among the given patterns.
+func (c *CandlestickPatternStrategy) patternsToAction(patterns pattern.Pattern) strategy.Action {
+	patterns &= c.Patterns
+
+	isBullish := patterns.IsBullish()
+	isBearish := patterns.IsBearish()
+
+	if isBullish && !isBearish {
+		return strategy.Buy
+	}
+
+	if isBearish && !isBullish {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (c *CandlestickPatternStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings -> trend ma
+	// snapshots[2] -> candles
+	// snapshots[3] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	ctx := context.Background()
+
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[1])
+	candles := asset.SnapshotsAsCandlestickReportColumnWithContext(ctx, "Candles", snapshotsSplice[2])
+
+	actions, outcomes := strategy.ComputeWithOutcome(c, snapshotsSplice[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(c.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	if c.TrendMa == nil {
+		report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	} else {
+		closingsSplice := helper.Duplicate(closings, 2)
+		mas := helper.Shift(c.TrendMa.Compute(closingsSplice[1]), c.TrendMa.IdlePeriod(), 0)
+
+		report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[0]))
+		report.AddColumn(helper.NewNumericReportColumn(c.TrendMa.String(), mas))
+	}
+	report.AddColumn(candles, 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (c *CandlestickPatternStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return c.ComputeWithContext(context.Background(), snapshots)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/pattern"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestCandlestickPatternStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/candlestick_pattern_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	candlestickPattern := pattern.NewCandlestickPatternStrategy()
+	actual := candlestickPattern.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCandlestickPatternStrategyWithTrendConfirmation(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/candlestick_pattern_strategy_with_trend_confirmation.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	candlestickPattern := pattern.NewCandlestickPatternStrategy()
+	candlestickPattern.TrendMa = trend.NewSmaWithPeriod[float64](pattern.DefaultCandlestickPatternStrategyTrendPeriod)
+	actual := candlestickPattern.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCandlestickPatternStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	candlestickPattern := pattern.NewCandlestickPatternStrategy()
+	candlestickPattern.TrendMa = trend.NewSmaWithPeriod[float64](pattern.DefaultCandlestickPatternStrategyTrendPeriod)
+
+	report := candlestickPattern.Report(snapshots)
+
+	fileName := "candlestick_pattern_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
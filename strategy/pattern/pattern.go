@@ -0,0 +1,36 @@
+// Package pattern contains the candlestick pattern strategy functions.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2026 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package pattern
+
+import (
+	"github.com/cinar/indicator/v2/pattern"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// AllStrategies returns a slice containing references to all available pattern strategies.
+func AllStrategies() []strategy.Strategy {
+	return []strategy.Strategy{
+		NewCandlestickPatternStrategy(),
+		NewCandlestickPatternStrategyWithTrendConfirmation(
+			pattern.AllPatterns,
+			trend.NewSmaWithPeriod[float64](DefaultCandlestickPatternStrategyTrendPeriod),
+		),
+	}
+}
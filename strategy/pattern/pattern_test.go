@@ -0,0 +1,18 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/strategy/pattern"
+)
+
+func TestAllStrategies(t *testing.T) {
+	strategies := pattern.AllStrategies()
+	if len(strategies) != 2 {
+		t.Fatalf("expected 2 strategies, got %d", len(strategies))
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultAdxStrategyThreshold is the default ADX level above which the trend is considered strong.
+	DefaultAdxStrategyThreshold = 25
+)
+
+// AdxStrategy represents the configuration parameters for calculating the ADX strategy.
+// It uses the ADX as a trend strength filter along with the directional indicators.
+// When the ADX is above the threshold, a +DI above the -DI suggests a strong uptrend
+// and a buy action, and a -DI above the +DI suggests a strong downtrend and a sell
+// action. Otherwise, the trend is considered weak and a hold action is recommended.
+type AdxStrategy struct {
+	// Adx represents the configuration parameters for calculating the ADX.
+	Adx *trend.Adx[float64]
+
+	// Threshold is the ADX level above which the trend is considered strong.
+	Threshold float64
+}
+
+// NewAdxStrategy function initializes a new ADX strategy instance with the default parameters.
+func NewAdxStrategy() *AdxStrategy {
+	return &AdxStrategy{
+		Adx:       trend.NewAdx[float64](),
+		Threshold: DefaultAdxStrategyThreshold,
+	}
+}
+
+// Name returns the name of the strategy.
+func (a *AdxStrategy) Name() string {
+	return fmt.Sprintf("ADX Strategy (%d,%.0f)", a.Adx.Period, a.Threshold)
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (a *AdxStrategy) ComputeWithContext(ctx context.Context, c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.DuplicateWithContext(ctx, c, 3)
+
+	highs := asset.SnapshotsAsHighsWithContext(ctx, snapshots[0])
+	lows := asset.SnapshotsAsLowsWithContext(ctx, snapshots[1])
+	closings := asset.SnapshotsAsClosingsWithContext(ctx, snapshots[2])
+
+	plusDis, minusDis, dxs, adxs := a.Adx.ComputeWithContext(ctx, highs, lows, closings)
+	go helper.DrainWithContext(ctx, dxs)
+
+	actions := helper.Operate3WithContext(ctx, plusDis, minusDis, adxs, func(plusDi, minusDi, adx float64) strategy.Action {
+		if adx <= a.Threshold {
+			return strategy.Hold
+		}
+
+		if plusDi > minusDi {
+			return strategy.Buy
+		}
+
+		if minusDi > plusDi {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// ADX starts only after the idle period.
+	actions = helper.ShiftWithContext(ctx, actions, a.Adx.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (a *AdxStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs    |
+	// snapshots[2] -> lows     | -> +DI, -DI, ADX
+	// snapshots[3] -> closings | -> closings
+	// snapshots[4] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	plusDis, minusDis, dxs, adxs := a.Adx.Compute(highs, lows, closings[0])
+	go helper.Drain(dxs)
+
+	plusDis = helper.Shift(plusDis, a.Adx.IdlePeriod(), 0)
+	minusDis = helper.Shift(minusDis, a.Adx.IdlePeriod(), 0)
+	adxs = helper.Shift(adxs, a.Adx.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(a, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(a.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[1]))
+	report.AddColumn(helper.NewNumericReportColumn("+DI", plusDis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("-DI", minusDis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("ADX", adxs), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (a *AdxStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	return a.ComputeWithContext(context.Background(), c)
+}
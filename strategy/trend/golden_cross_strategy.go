@@ -6,6 +6,7 @@ package trend
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
@@ -22,15 +23,26 @@ const (
 )
 
 // GoldenCrossStrategy defines the parameters used to calculate the Golden Cross trading strategy. This strategy uses
-// two Exponential Moving Averages (EMAs) with different lengths to identify potential buy and sell signals.
-// - A buy signal is generated when the **fastest** EMA crosses above the **slowest** EMAs.
-// - A sell signal is generated when the fastest EMA crosses below the slowest EMAs.
+// two Moving Averages (MAs) with different lengths, which are Exponential Moving Averages (EMAs) by default, to
+// identify potential buy and sell signals.
+// - A buy signal is generated when the **fastest** MA crosses above the **slowest** MAs.
+// - A sell signal is generated when the fastest MA crosses below the slowest MAs.
 // - Otherwise, the strategy recommends holding the asset.
 type GoldenCrossStrategy struct {
+	// FastMa is the fastest MA.
+	FastMa trend.Ma[float64]
+
+	// SlowMa is the slowest MA.
+	SlowMa trend.Ma[float64]
+
 	// FastEma is the fastest EMA.
+	//
+	// Deprecated: Use FastMa instead. It is used only when FastMa is nil.
 	FastEma *trend.Ema[float64]
 
 	// SlowEma is the slowest EMA.
+	//
+	// Deprecated: Use SlowMa instead. It is used only when SlowMa is nil.
 	SlowEma *trend.Ema[float64]
 }
 
@@ -44,29 +56,64 @@ func NewGoldenCrossStrategy() *GoldenCrossStrategy {
 
 // NewGoldenCrossStrategyWith function initializes a new Golden Cross strategy instance with the given periods.
 func NewGoldenCrossStrategyWith(fastPeriod, slowPeriod int) *GoldenCrossStrategy {
+	return NewGoldenCrossStrategyWithMa(
+		trend.NewEmaWithPeriod[float64](fastPeriod),
+		trend.NewEmaWithPeriod[float64](slowPeriod),
+	)
+}
+
+// NewGoldenCrossStrategyWithMa function initializes a new Golden Cross strategy instance with the given MAs.
+func NewGoldenCrossStrategyWithMa(fastMa, slowMa trend.Ma[float64]) *GoldenCrossStrategy {
+	fastEma, _ := fastMa.(*trend.Ema[float64])
+	slowEma, _ := slowMa.(*trend.Ema[float64])
+
 	return &GoldenCrossStrategy{
-		FastEma: trend.NewEmaWithPeriod[float64](fastPeriod),
-		SlowEma: trend.NewEmaWithPeriod[float64](slowPeriod),
+		FastMa:  fastMa,
+		SlowMa:  slowMa,
+		FastEma: fastEma,
+		SlowEma: slowEma,
 	}
 }
 
+// NewGoldenCrossStrategyWithMaName function initializes a new Golden Cross strategy instance with the MAs of the
+// given name, such as "sma" or "alma", and the given periods.
+func NewGoldenCrossStrategyWithMaName(name string, fastPeriod, slowPeriod int) (*GoldenCrossStrategy, error) {
+	fastMa, err := trend.NewMaByName[float64](name, fastPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	slowMa, err := trend.NewMaByName[float64](name, slowPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGoldenCrossStrategyWithMa(fastMa, slowMa), nil
+}
+
 // Name returns the name of the strategy.
-func (*GoldenCrossStrategy) Name() string {
-	return "Golden Cross Strategy"
+func (t *GoldenCrossStrategy) Name() string {
+	fastMa, slowMa := t.mas()
+
+	if allEmas(fastMa, slowMa) {
+		return "Golden Cross Strategy"
+	}
+
+	return fmt.Sprintf("Golden Cross Strategy (%s,%s)", fastMa, slowMa)
 }
 
 // ComputeWithContext processes the provided asset snapshots and generates a stream of actionable recommendations.
 func (t *GoldenCrossStrategy) ComputeWithContext(ctx context.Context, c <-chan *asset.Snapshot) <-chan strategy.Action {
-	fastEmas, slowEmas := t.calculateEmas(c)
+	fastMas, slowMas := t.calculateMasWithContext(ctx, c)
 
-	actions := helper.OperateWithContext(ctx, fastEmas, slowEmas, func(fastEma, slowEma float64) strategy.Action {
-		// A buy signal is generated when the **fastest** EMA crosses above the **slowest** EMAs.
-		if fastEma > slowEma {
+	actions := helper.OperateWithContext(ctx, fastMas, slowMas, func(fastMa, slowMa float64) strategy.Action {
+		// A buy signal is generated when the **fastest** MA crosses above the **slowest** MAs.
+		if fastMa > slowMa {
 			return strategy.Buy
 		}
 
-		// A sell signal is generated when the fastest EMA crosses below the slowest EMAs.
-		if fastEma < slowEma {
+		// A sell signal is generated when the fastest MA crosses below the slowest MAs.
+		if fastMa < slowMa {
 			return strategy.Sell
 		}
 
@@ -75,7 +122,7 @@ func (t *GoldenCrossStrategy) ComputeWithContext(ctx context.Context, c <-chan *
 	})
 
 	// Generate a Hold signal during the idle period.
-	actions = helper.ShiftWithContext(ctx, actions, t.SlowEma.IdlePeriod(), strategy.Hold)
+	actions = helper.ShiftWithContext(ctx, actions, t.idlePeriod(), strategy.Hold)
 
 	return actions
 }
@@ -86,9 +133,8 @@ func (t *GoldenCrossStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 	//
 	// snapshots[0] -> dates
 	// snapshots[1] -> closings
-	// snapshots[2] -> fastEmas
-	//                 mediumEmas
-	//                 slowEmas
+	// snapshots[2] -> fastMas
+	//                 slowMas
 	// snapshots[3] -> actions     -> annotations
 	//              -> outcomes
 	//
@@ -96,30 +142,30 @@ func (t *GoldenCrossStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	dates := helper.Skip(
 		asset.SnapshotsAsDates(snapshots[0]),
-		t.SlowEma.IdlePeriod(),
+		t.idlePeriod(),
 	)
 
 	closingsSplice := helper.Duplicate(
 		helper.Skip(
 			asset.SnapshotsAsClosings(snapshots[1]),
-			t.SlowEma.IdlePeriod(),
+			t.idlePeriod(),
 		),
 		2,
 	)
 
-	fastEmas, slowEmas := t.calculateEmas(snapshots[2])
+	fastMas, slowMas := t.calculateMasWithContext(context.Background(), snapshots[2])
 
 	actions, outcomes := strategy.ComputeWithOutcome(t, snapshots[3])
 
 	annotations := helper.Skip(
 		strategy.ActionsToAnnotations(actions),
-		t.SlowEma.IdlePeriod(),
+		t.idlePeriod(),
 	)
 
 	outcomes = helper.MultiplyBy(
 		helper.Skip(
 			outcomes,
-			t.SlowEma.IdlePeriod(),
+			t.idlePeriod(),
 		),
 		100,
 	)
@@ -131,8 +177,8 @@ func (t *GoldenCrossStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[0]))
 
 	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]), 1)
-	report.AddColumn(helper.NewNumericReportColumn("Fast", fastEmas), 1)
-	report.AddColumn(helper.NewNumericReportColumn("Slow", slowEmas), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Fast", fastMas), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Slow", slowMas), 1)
 
 	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
 	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
@@ -140,18 +186,55 @@ func (t *GoldenCrossStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 	return report
 }
 
-// calculateEmas calculates the fast and slow EMAs.
-func (t *GoldenCrossStrategy) calculateEmas(c <-chan *asset.Snapshot) (<-chan float64, <-chan float64) {
-	closings := helper.Duplicate(asset.SnapshotsAsClosings(c), 2)
+// calculateMasWithContext calculates the fast and slow MAs, aligned on the longest idle period.
+func (t *GoldenCrossStrategy) calculateMasWithContext(ctx context.Context, c <-chan *asset.Snapshot) (<-chan float64, <-chan float64) {
+	closings := helper.DuplicateWithContext(ctx, asset.SnapshotsAsClosingsWithContext(ctx, c), 2)
+
+	fastMa, slowMa := t.mas()
+	idlePeriod := t.idlePeriod()
+
+	fastMas := helper.SkipWithContext(ctx,
+		trend.ComputeMaWithContext(ctx, fastMa, closings[0]),
+		idlePeriod-fastMa.IdlePeriod(),
+	)
 
-	fastEmas := helper.Skip(
-		t.FastEma.Compute(closings[0]),
-		t.SlowEma.IdlePeriod()-t.FastEma.IdlePeriod(),
+	slowMas := helper.SkipWithContext(ctx,
+		trend.ComputeMaWithContext(ctx, slowMa, closings[1]),
+		idlePeriod-slowMa.IdlePeriod(),
 	)
 
-	slowEmas := t.SlowEma.Compute(closings[1])
+	return fastMas, slowMas
+}
+
+// mas returns the fast and slow MAs, falling back to the deprecated EMA fields.
+func (t *GoldenCrossStrategy) mas() (trend.Ma[float64], trend.Ma[float64]) {
+	return maOrEma(t.FastMa, t.FastEma), maOrEma(t.SlowMa, t.SlowEma)
+}
+
+// idlePeriod returns the longest idle period of the MAs.
+func (t *GoldenCrossStrategy) idlePeriod() int {
+	fastMa, slowMa := t.mas()
+	return max(fastMa.IdlePeriod(), slowMa.IdlePeriod())
+}
+
+// maOrEma returns the given MA, or the given deprecated EMA if the MA is nil.
+func maOrEma(ma trend.Ma[float64], ema *trend.Ema[float64]) trend.Ma[float64] {
+	if ma == nil && ema != nil {
+		return ema
+	}
+
+	return ma
+}
+
+// allEmas checks if the given MAs are all EMAs.
+func allEmas(mas ...trend.Ma[float64]) bool {
+	for _, ma := range mas {
+		if _, ok := ma.(*trend.Ema[float64]); !ok {
+			return false
+		}
+	}
 
-	return fastEmas, slowEmas
+	return true
 }
 
 // Compute wraps ComputeWithContext for backwards compatibility.
@@ -5,12 +5,15 @@
 package trend_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/strategy"
 	"github.com/cinar/indicator/v2/strategy/trend"
+	indicatortrend "github.com/cinar/indicator/v2/trend"
 )
 
 func TestGoldenCrossStrategy(t *testing.T) {
@@ -53,3 +56,135 @@ func TestGoldenCrossStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGoldenCrossStrategyWithMaName(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/golden_cross_strategy_alma.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	gcs, err := trend.NewGoldenCrossStrategyWithMaName("alma", 5, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := gcs.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGoldenCrossStrategyWithMaNameReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gcs, err := trend.NewGoldenCrossStrategyWithMaName("alma", 5, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := gcs.Report(snapshots)
+
+	fileName := "golden_cross_strategy_alma.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGoldenCrossStrategyWithMaNameUnknown(t *testing.T) {
+	_, err := trend.NewGoldenCrossStrategyWithMaName("unknown", 5, 20)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGoldenCrossStrategyName(t *testing.T) {
+	expected := "Golden Cross Strategy"
+	actual := trend.NewGoldenCrossStrategy().Name()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	gcs, err := trend.NewGoldenCrossStrategyWithMaName("sma", 5, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "Golden Cross Strategy (SMA(5),SMA(20))"
+	actual = gcs.Name()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestGoldenCrossStrategyMixedMas(t *testing.T) {
+	rows, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots := helper.ChanToSlice(rows)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The fast MA has the longest idle period.
+	fastMa, err := indicatortrend.NewMaByName[float64]("tema", 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gcs := trend.NewGoldenCrossStrategyWithMa(fastMa, indicatortrend.NewSmaWithPeriod[float64](50))
+
+	actual := helper.ChanToSlice(gcs.ComputeWithContext(ctx, helper.SliceToChan(snapshots)))
+
+	if ctx.Err() != nil {
+		t.Fatal(ctx.Err())
+	}
+
+	if len(actual) != len(snapshots) {
+		t.Fatalf("actual %v expected %v actions", len(actual), len(snapshots))
+	}
+}
+
+func TestGoldenCrossStrategyDeprecatedEmas(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/golden_cross_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	gcs := &trend.GoldenCrossStrategy{
+		FastEma: indicatortrend.NewEmaWithPeriod[float64](5),
+		SlowEma: indicatortrend.NewEmaWithPeriod[float64](20),
+	}
+
+	actual := gcs.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
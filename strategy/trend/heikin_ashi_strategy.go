@@ -0,0 +1,117 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+const (
+	// DefaultHeikinAshiStrategyCount is the default number of consecutive candles.
+	DefaultHeikinAshiStrategyCount = 2
+)
+
+// HeikinAshiStrategy represents the configuration parameters for calculating the
+// Heikin-Ashi strategy. Heikin-Ashi candles without a lower wick are strongly
+// bullish, and the ones without an upper wick are strongly bearish. Consecutive
+// strongly bullish candles suggest an uptrend and a buy action, and consecutive
+// strongly bearish candles suggest a downtrend and a sell action.
+type HeikinAshiStrategy struct {
+	// Count is the number of consecutive candles.
+	Count int
+}
+
+// NewHeikinAshiStrategy function initializes a new Heikin-Ashi strategy instance
+// with the default parameters.
+func NewHeikinAshiStrategy() *HeikinAshiStrategy {
+	return &HeikinAshiStrategy{
+		Count: DefaultHeikinAshiStrategyCount,
+	}
+}
+
+// Name returns the name of the strategy.
+func (h *HeikinAshiStrategy) Name() string {
+	return fmt.Sprintf("Heikin-Ashi Strategy (%d)", h.Count)
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (h *HeikinAshiStrategy) ComputeWithContext(ctx context.Context, c <-chan *asset.Snapshot) <-chan strategy.Action {
+	bullish := 0
+	bearish := 0
+
+	return helper.MapWithContext(ctx, asset.SnapshotsAsHeikinAshiWithContext(ctx, c), func(candle *asset.Snapshot) strategy.Action {
+		// Bullish candle without a lower wick.
+		if candle.Close > candle.Open && candle.Low == candle.Open {
+			bullish++
+		} else {
+			bullish = 0
+		}
+
+		// Bearish candle without an upper wick.
+		if candle.Close < candle.Open && candle.High == candle.Open {
+			bearish++
+		} else {
+			bearish = 0
+		}
+
+		if bullish >= h.Count {
+			return strategy.Buy
+		}
+
+		if bearish >= h.Count {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (h *HeikinAshiStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> heikin-ashi -> candles
+	// snapshots[3] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	ctx := context.Background()
+
+	snapshots := helper.Duplicate(c, 4)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+	candles := asset.SnapshotsAsCandlestickReportColumnWithContext(ctx, "Heikin-Ashi", asset.SnapshotsAsHeikinAshiWithContext(ctx, snapshots[2]))
+
+	actions, outcomes := strategy.ComputeWithOutcome(h, snapshots[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(h.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(candles, 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (h *HeikinAshiStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	return h.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// MamaStrategy represents the configuration parameters for calculating the
+// MAMA strategy. The MAMA crossing above the FAMA suggests a bullish trend,
+// while the MAMA crossing below the FAMA indicates a bearish trend.
+type MamaStrategy struct {
+	// Mama represents the configuration parameters for calculating the
+	// MESA Adaptive Moving Average (MAMA) and the Following Adaptive
+	// Moving Average (FAMA).
+	Mama *trend.Mama[float64]
+}
+
+// NewMamaStrategy function initializes a new MAMA strategy instance.
+func NewMamaStrategy() *MamaStrategy {
+	return NewMamaStrategyWith(
+		trend.DefaultMamaFastLimit,
+		trend.DefaultMamaSlowLimit,
+	)
+}
+
+// NewMamaStrategyWith function initializes a new MAMA strategy instance with the given limits.
+func NewMamaStrategyWith(fastLimit, slowLimit float64) *MamaStrategy {
+	return &MamaStrategy{
+		Mama: trend.NewMamaWithLimits(fastLimit, slowLimit),
+	}
+}
+
+// Name returns the name of the strategy.
+func (m *MamaStrategy) Name() string {
+	return fmt.Sprintf("MAMA Strategy (%v,%v)", m.Mama.FastLimit, m.Mama.SlowLimit)
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (m *MamaStrategy) ComputeWithContext(ctx context.Context, snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	closings := asset.SnapshotsAsClosingsWithContext(ctx, snapshots)
+
+	mamas, famas := m.Mama.ComputeWithContext(ctx, closings)
+
+	var previousMama, previousFama float64
+	first := true
+
+	actions := helper.OperateWithContext(ctx, mamas, famas, func(mama, fama float64) strategy.Action {
+		action := strategy.Hold
+
+		if !first {
+			if previousMama <= previousFama && mama > fama {
+				// The MAMA crossing above the FAMA suggests a bullish trend.
+				action = strategy.Buy
+			} else if previousMama >= previousFama && mama < fama {
+				// The MAMA crossing below the FAMA suggests a bearish trend.
+				action = strategy.Sell
+			}
+		}
+
+		first = false
+		previousMama, previousFama = mama, fama
+
+		return action
+	})
+
+	// MAMA starts only after the idle period.
+	actions = helper.ShiftWithContext(ctx, actions, m.Mama.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (m *MamaStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> closings
+	//                 closings[1] -> mamas, famas
+	// snapshots[2] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[1]), 2)
+
+	mamas, famas := m.Mama.Compute(closings[0])
+	mamas = helper.Shift(mamas, m.Mama.IdlePeriod(), 0)
+	famas = helper.Shift(famas, m.Mama.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(m, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(m.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[1]))
+	report.AddColumn(helper.NewNumericReportColumn("MAMA", mamas))
+	report.AddColumn(helper.NewNumericReportColumn("FAMA", famas))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MamaStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return m.ComputeWithContext(context.Background(), snapshots)
+}
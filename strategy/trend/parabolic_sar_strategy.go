@@ -0,0 +1,109 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// ParabolicSarStrategy represents the configuration parameters for calculating the
+// Parabolic SAR strategy. It stays in the market and flips its position on the SAR
+// reversals. When the SAR is below the prices, it suggests an uptrend and a buy
+// action, and when the SAR is above the prices, it suggests a downtrend and a
+// sell action.
+type ParabolicSarStrategy struct {
+	// ParabolicSar represents the configuration parameters for calculating the Parabolic SAR.
+	ParabolicSar *trend.ParabolicSar[float64]
+}
+
+// NewParabolicSarStrategy function initializes a new Parabolic SAR strategy instance
+// with the default parameters.
+func NewParabolicSarStrategy() *ParabolicSarStrategy {
+	return &ParabolicSarStrategy{
+		ParabolicSar: trend.NewParabolicSar[float64](),
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *ParabolicSarStrategy) Name() string {
+	return fmt.Sprintf("Parabolic SAR Strategy (%v,%v)", p.ParabolicSar.Step, p.ParabolicSar.Maximum)
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (p *ParabolicSarStrategy) ComputeWithContext(ctx context.Context, c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.DuplicateWithContext(ctx, c, 2)
+
+	highs := asset.SnapshotsAsHighsWithContext(ctx, snapshots[0])
+	lows := asset.SnapshotsAsLowsWithContext(ctx, snapshots[1])
+
+	sars, trends := p.ParabolicSar.ComputeWithContext(ctx, highs, lows)
+	go helper.DrainWithContext(ctx, sars)
+
+	actions := helper.MapWithContext(ctx, trends, func(direction float64) strategy.Action {
+		if direction == trend.ParabolicSarUp {
+			return strategy.Buy
+		}
+
+		return strategy.Sell
+	})
+
+	// Parabolic SAR starts only after the idle period.
+	actions = helper.ShiftWithContext(ctx, actions, p.ParabolicSar.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (p *ParabolicSarStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs    |> sars
+	// snapshots[2] -> lows     |
+	// snapshots[3] -> closings
+	// snapshots[4] -> Compute -> actions  -> annotations
+	//                            outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := asset.SnapshotsAsClosings(snapshots[3])
+
+	sars, trends := p.ParabolicSar.Compute(highs, lows)
+	go helper.Drain(trends)
+
+	sars = helper.Shift(sars, p.ParabolicSar.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewNumericReportColumn("SAR", sars))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (p *ParabolicSarStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	return p.ComputeWithContext(context.Background(), c)
+}
@@ -23,6 +23,7 @@ import "github.com/cinar/indicator/v2/strategy"
 // AllStrategies returns a slice containing references to all available trend strategies.
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
+		NewAdxStrategy(),
 		NewAlligatorStrategy(),
 		NewApoStrategy(),
 		NewAroonStrategy(),
@@ -31,10 +32,13 @@ func AllStrategies() []strategy.Strategy {
 		NewCfoStrategy(),
 		NewDemaStrategy(),
 		NewGoldenCrossStrategy(),
+		NewHeikinAshiStrategy(),
 		NewHmaStrategy(),
 		NewKamaStrategy(),
 		NewKdjStrategy(),
 		NewMacdStrategy(),
+		NewMamaStrategy(),
+		NewParabolicSarStrategy(),
 		NewQstickStrategy(),
 		NewSmmaStrategy(),
 		NewTrimaStrategy(),
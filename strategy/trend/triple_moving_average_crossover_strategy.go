@@ -6,6 +6,7 @@ package trend
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
@@ -25,19 +26,34 @@ const (
 )
 
 // TripleMovingAverageCrossoverStrategy defines the parameters used to calculate the Triple Moving Average Crossover
-// trading strategy. This strategy uses three Exponential Moving Averages (EMAs) with different lengths to identify
-// potential buy and sell signals.
-// - A buy signal is generated when the **fastest** EMA crosses above both the **medium** and **slowest** EMAs.
-// - A sell signal is generated when the fastest EMA crosses below both the medium and slowest EMAs.
+// trading strategy. This strategy uses three Moving Averages (MAs) with different lengths, which are Exponential
+// Moving Averages (EMAs) by default, to identify potential buy and sell signals.
+// - A buy signal is generated when the **fastest** MA crosses above both the **medium** and **slowest** MAs.
+// - A sell signal is generated when the fastest MA crosses below both the medium and slowest MAs.
 // - Otherwise, the strategy recommends holding the asset.
 type TripleMovingAverageCrossoverStrategy struct {
+	// FastMa is the fastest MA.
+	FastMa trend.Ma[float64]
+
+	// MediumMa is the medium MA.
+	MediumMa trend.Ma[float64]
+
+	// SlowMa is the slowest MA.
+	SlowMa trend.Ma[float64]
+
 	// FastEma is the fastest EMA.
+	//
+	// Deprecated: Use FastMa instead. It is used only when FastMa is nil.
 	FastEma *trend.Ema[float64]
 
-	// MediumEma is the meium EMA.
+	// MediumEma is the medium EMA.
+	//
+	// Deprecated: Use MediumMa instead. It is used only when MediumMa is nil.
 	MediumEma *trend.Ema[float64]
 
 	// SlowEma is the slowest EMA.
+	//
+	// Deprecated: Use SlowMa instead. It is used only when SlowMa is nil.
 	SlowEma *trend.Ema[float64]
 }
 
@@ -52,30 +68,73 @@ func NewTripleMovingAverageCrossoverStrategy() *TripleMovingAverageCrossoverStra
 
 // NewTripleMovingAverageCrossoverStrategyWith function initializes a new Triple Moving Average Crossover strategy instance with the given periods.
 func NewTripleMovingAverageCrossoverStrategyWith(fastPeriod, mediumPeriod, slowPeriod int) *TripleMovingAverageCrossoverStrategy {
+	return NewTripleMovingAverageCrossoverStrategyWithMa(
+		trend.NewEmaWithPeriod[float64](fastPeriod),
+		trend.NewEmaWithPeriod[float64](mediumPeriod),
+		trend.NewEmaWithPeriod[float64](slowPeriod),
+	)
+}
+
+// NewTripleMovingAverageCrossoverStrategyWithMa function initializes a new Triple Moving Average Crossover strategy instance with the given MAs.
+func NewTripleMovingAverageCrossoverStrategyWithMa(fastMa, mediumMa, slowMa trend.Ma[float64]) *TripleMovingAverageCrossoverStrategy {
+	fastEma, _ := fastMa.(*trend.Ema[float64])
+	mediumEma, _ := mediumMa.(*trend.Ema[float64])
+	slowEma, _ := slowMa.(*trend.Ema[float64])
+
 	return &TripleMovingAverageCrossoverStrategy{
-		FastEma:   trend.NewEmaWithPeriod[float64](fastPeriod),
-		MediumEma: trend.NewEmaWithPeriod[float64](mediumPeriod),
-		SlowEma:   trend.NewEmaWithPeriod[float64](slowPeriod),
+		FastMa:    fastMa,
+		MediumMa:  mediumMa,
+		SlowMa:    slowMa,
+		FastEma:   fastEma,
+		MediumEma: mediumEma,
+		SlowEma:   slowEma,
 	}
 }
 
+// NewTripleMovingAverageCrossoverStrategyWithMaName function initializes a new Triple Moving Average Crossover
+// strategy instance with the MAs of the given name, such as "sma" or "alma", and the given periods.
+func NewTripleMovingAverageCrossoverStrategyWithMaName(name string, fastPeriod, mediumPeriod, slowPeriod int) (*TripleMovingAverageCrossoverStrategy, error) {
+	fastMa, err := trend.NewMaByName[float64](name, fastPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	mediumMa, err := trend.NewMaByName[float64](name, mediumPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	slowMa, err := trend.NewMaByName[float64](name, slowPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTripleMovingAverageCrossoverStrategyWithMa(fastMa, mediumMa, slowMa), nil
+}
+
 // Name returns the name of the strategy.
-func (*TripleMovingAverageCrossoverStrategy) Name() string {
-	return "Triple Moving Average Crossover Strategy"
+func (t *TripleMovingAverageCrossoverStrategy) Name() string {
+	fastMa, mediumMa, slowMa := t.mas()
+
+	if allEmas(fastMa, mediumMa, slowMa) {
+		return "Triple Moving Average Crossover Strategy"
+	}
+
+	return fmt.Sprintf("Triple Moving Average Crossover Strategy (%s,%s,%s)", fastMa, mediumMa, slowMa)
 }
 
 // ComputeWithContext processes the provided asset snapshots and generates a stream of actionable recommendations.
 func (t *TripleMovingAverageCrossoverStrategy) ComputeWithContext(ctx context.Context, c <-chan *asset.Snapshot) <-chan strategy.Action {
-	fastEmas, mediumEmas, slowEmas := t.calculateEmas(c)
+	fastMas, mediumMas, slowMas := t.calculateMasWithContext(ctx, c)
 
-	actions := helper.Operate3WithContext(ctx, fastEmas, mediumEmas, slowEmas, func(fastEma, mediumEma, slowEma float64) strategy.Action {
-		// A buy signal is generated when the **fastest** EMA crosses above both the **medium** and **slowest** EMAs.
-		if (fastEma > mediumEma) && (fastEma > slowEma) {
+	actions := helper.Operate3WithContext(ctx, fastMas, mediumMas, slowMas, func(fastMa, mediumMa, slowMa float64) strategy.Action {
+		// A buy signal is generated when the **fastest** MA crosses above both the **medium** and **slowest** MAs.
+		if (fastMa > mediumMa) && (fastMa > slowMa) {
 			return strategy.Buy
 		}
 
-		// A sell signal is generated when the fastest EMA crosses below both the medium and slowest EMAs.
-		if (fastEma < mediumEma) && (fastEma < slowEma) {
+		// A sell signal is generated when the fastest MA crosses below both the medium and slowest MAs.
+		if (fastMa < mediumMa) && (fastMa < slowMa) {
 			return strategy.Sell
 		}
 
@@ -84,7 +143,7 @@ func (t *TripleMovingAverageCrossoverStrategy) ComputeWithContext(ctx context.Co
 	})
 
 	// Generate a Hold signal during the idle period.
-	actions = helper.ShiftWithContext(ctx, actions, t.SlowEma.IdlePeriod(), strategy.Hold)
+	actions = helper.ShiftWithContext(ctx, actions, t.idlePeriod(), strategy.Hold)
 
 	return actions
 }
@@ -95,9 +154,9 @@ func (t *TripleMovingAverageCrossoverStrategy) Report(c <-chan *asset.Snapshot)
 	//
 	// snapshots[0] -> dates
 	// snapshots[1] -> closings
-	// snapshots[2] -> fastEmas
-	//                 mediumEmas
-	//                 slowEmas
+	// snapshots[2] -> fastMas
+	//                 mediumMas
+	//                 slowMas
 	// snapshots[3] -> actions     -> annotations
 	//              -> outcomes
 	//
@@ -105,30 +164,30 @@ func (t *TripleMovingAverageCrossoverStrategy) Report(c <-chan *asset.Snapshot)
 
 	dates := helper.Skip(
 		asset.SnapshotsAsDates(snapshots[0]),
-		t.SlowEma.IdlePeriod(),
+		t.idlePeriod(),
 	)
 
 	closingsSplice := helper.Duplicate(
 		helper.Skip(
 			asset.SnapshotsAsClosings(snapshots[1]),
-			t.SlowEma.IdlePeriod(),
+			t.idlePeriod(),
 		),
 		2,
 	)
 
-	fastEmas, mediumEmas, slowEmas := t.calculateEmas(snapshots[2])
+	fastMas, mediumMas, slowMas := t.calculateMasWithContext(context.Background(), snapshots[2])
 
 	actions, outcomes := strategy.ComputeWithOutcome(t, snapshots[3])
 
 	annotations := helper.Skip(
 		strategy.ActionsToAnnotations(actions),
-		t.SlowEma.IdlePeriod(),
+		t.idlePeriod(),
 	)
 
 	outcomes = helper.MultiplyBy(
 		helper.Skip(
 			outcomes,
-			t.SlowEma.IdlePeriod(),
+			t.idlePeriod(),
 		),
 		100,
 	)
@@ -140,9 +199,9 @@ func (t *TripleMovingAverageCrossoverStrategy) Report(c <-chan *asset.Snapshot)
 	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[0]))
 
 	report.AddColumn(helper.NewNumericReportColumn("Close", closingsSplice[1]), 1)
-	report.AddColumn(helper.NewNumericReportColumn("Fast", fastEmas), 1)
-	report.AddColumn(helper.NewNumericReportColumn("Medium", mediumEmas), 1)
-	report.AddColumn(helper.NewNumericReportColumn("Slow", slowEmas), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Fast", fastMas), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Medium", mediumMas), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Slow", slowMas), 1)
 
 	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
 	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
@@ -150,23 +209,40 @@ func (t *TripleMovingAverageCrossoverStrategy) Report(c <-chan *asset.Snapshot)
 	return report
 }
 
-// calculateEmas calculates the fast, medium, and slow EMAs.
-func (t *TripleMovingAverageCrossoverStrategy) calculateEmas(c <-chan *asset.Snapshot) (<-chan float64, <-chan float64, <-chan float64) {
-	closings := helper.Duplicate(asset.SnapshotsAsClosings(c), 3)
+// calculateMasWithContext calculates the fast, medium, and slow MAs, aligned on the longest idle period.
+func (t *TripleMovingAverageCrossoverStrategy) calculateMasWithContext(ctx context.Context, c <-chan *asset.Snapshot) (<-chan float64, <-chan float64, <-chan float64) {
+	closings := helper.DuplicateWithContext(ctx, asset.SnapshotsAsClosingsWithContext(ctx, c), 3)
 
-	fastEmas := helper.Skip(
-		t.FastEma.Compute(closings[0]),
-		t.SlowEma.IdlePeriod()-t.FastEma.IdlePeriod(),
+	fastMa, mediumMa, slowMa := t.mas()
+	idlePeriod := t.idlePeriod()
+
+	fastMas := helper.SkipWithContext(ctx,
+		trend.ComputeMaWithContext(ctx, fastMa, closings[0]),
+		idlePeriod-fastMa.IdlePeriod(),
+	)
+
+	mediumMas := helper.SkipWithContext(ctx,
+		trend.ComputeMaWithContext(ctx, mediumMa, closings[1]),
+		idlePeriod-mediumMa.IdlePeriod(),
 	)
 
-	mediumEmas := helper.Skip(
-		t.MediumEma.Compute(closings[1]),
-		t.SlowEma.IdlePeriod()-t.MediumEma.IdlePeriod(),
+	slowMas := helper.SkipWithContext(ctx,
+		trend.ComputeMaWithContext(ctx, slowMa, closings[2]),
+		idlePeriod-slowMa.IdlePeriod(),
 	)
 
-	slowEmas := t.SlowEma.Compute(closings[2])
+	return fastMas, mediumMas, slowMas
+}
+
+// mas returns the fast, medium, and slow MAs, falling back to the deprecated EMA fields.
+func (t *TripleMovingAverageCrossoverStrategy) mas() (trend.Ma[float64], trend.Ma[float64], trend.Ma[float64]) {
+	return maOrEma(t.FastMa, t.FastEma), maOrEma(t.MediumMa, t.MediumEma), maOrEma(t.SlowMa, t.SlowEma)
+}
 
-	return fastEmas, mediumEmas, slowEmas
+// idlePeriod returns the longest idle period of the MAs.
+func (t *TripleMovingAverageCrossoverStrategy) idlePeriod() int {
+	fastMa, mediumMa, slowMa := t.mas()
+	return max(fastMa.IdlePeriod(), mediumMa.IdlePeriod(), slowMa.IdlePeriod())
 }
 
 // Compute wraps ComputeWithContext for backwards compatibility.
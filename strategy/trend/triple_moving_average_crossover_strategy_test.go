@@ -5,12 +5,15 @@
 package trend_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/strategy"
 	"github.com/cinar/indicator/v2/strategy/trend"
+	indicatortrend "github.com/cinar/indicator/v2/trend"
 )
 
 func TestTripleMovingAverageCrossoverStrategy(t *testing.T) {
@@ -53,3 +56,114 @@ func TestTripleMovingAverageCrossoverStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTripleMovingAverageCrossoverStrategyWithMaName(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/triple_moving_average_crossover_strategy_zlema.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	tmacStrategy, err := trend.NewTripleMovingAverageCrossoverStrategyWithMaName("zlema", 5, 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := tmacStrategy.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTripleMovingAverageCrossoverStrategyWithMaNameReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmacStrategy, err := trend.NewTripleMovingAverageCrossoverStrategyWithMaName("zlema", 5, 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := tmacStrategy.Report(snapshots)
+
+	fileName := "triple_moving_average_crossover_strategy_zlema.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTripleMovingAverageCrossoverStrategyWithMaNameUnknown(t *testing.T) {
+	_, err := trend.NewTripleMovingAverageCrossoverStrategyWithMaName("unknown", 5, 10, 20)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTripleMovingAverageCrossoverStrategyName(t *testing.T) {
+	expected := "Triple Moving Average Crossover Strategy"
+	actual := trend.NewTripleMovingAverageCrossoverStrategy().Name()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	tmacStrategy, err := trend.NewTripleMovingAverageCrossoverStrategyWithMaName("sma", 5, 10, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = "Triple Moving Average Crossover Strategy (SMA(5),SMA(10),SMA(20))"
+	actual = tmacStrategy.Name()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestTripleMovingAverageCrossoverStrategyMixedMas(t *testing.T) {
+	rows, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshots := helper.ChanToSlice(rows)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The fast MA has the longest idle period, and the medium MA the shortest.
+	fastMa, err := indicatortrend.NewMaByName[float64]("tema", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slowMa, err := indicatortrend.NewMaByName[float64]("dema", 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmacStrategy := trend.NewTripleMovingAverageCrossoverStrategyWithMa(fastMa, indicatortrend.NewSmaWithPeriod[float64](30), slowMa)
+
+	actual := helper.ChanToSlice(tmacStrategy.ComputeWithContext(ctx, helper.SliceToChan(snapshots)))
+
+	if ctx.Err() != nil {
+		t.Fatal(ctx.Err())
+	}
+
+	if len(actual) != len(snapshots) {
+		t.Fatalf("actual %v expected %v actions", len(actual), len(snapshots))
+	}
+}
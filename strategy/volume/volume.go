@@ -31,6 +31,7 @@ func AllStrategies() []strategy.Strategy {
 		NewMoneyFlowIndexStrategy(),
 		NewNegativeVolumeIndexStrategy(),
 		NewObvStrategy(),
+		NewVwapBandStrategy(),
 		NewWeightedAveragePriceStrategy(),
 	}
 }
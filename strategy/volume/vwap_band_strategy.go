@@ -0,0 +1,137 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// VwapBandStrategy represents the configuration parameters for calculating the
+// VWAP band mean reversion strategy. It expects the prices to revert to the
+// anchored VWAP. Recommends a Buy action when the closing falls below the
+// lower band, recommends a Sell action when the closing rises above the upper
+// band, and recommends a Hold action otherwise. Since the bands have no width
+// at the first period of each bucket, a Hold action is recommended until the
+// standard deviation is positive.
+//
+// The VWAP restarts at each month by default, which suits the daily
+// snapshots. For the intraday snapshots, the VWAP can restart at each session.
+//
+// Example:
+//
+//	strategy := volume.NewVwapBandStrategyWith(helper.DayBucket, 2)
+type VwapBandStrategy struct {
+	// AnchoredVwap is the anchored VWAP indicator instance.
+	AnchoredVwap *volume.AnchoredVwap[float64]
+}
+
+// NewVwapBandStrategy function initializes a new VWAP band strategy instance
+// with the default parameters.
+func NewVwapBandStrategy() *VwapBandStrategy {
+	return NewVwapBandStrategyWith(helper.MonthBucket, volume.DefaultAnchoredVwapBandMultiplier)
+}
+
+// NewVwapBandStrategyWith function initializes a new VWAP band strategy
+// instance with the given time bucket that the VWAP restarts at, and the
+// given standard deviation multiplier of the bands.
+func NewVwapBandStrategyWith(bucket helper.TimeBucket, multiplier float64) *VwapBandStrategy {
+	anchoredVwap := volume.NewAnchoredVwapWithBucket[float64](bucket)
+	anchoredVwap.Multiplier = multiplier
+
+	return &VwapBandStrategy{
+		AnchoredVwap: anchoredVwap,
+	}
+}
+
+// Name returns the name of the strategy.
+func (v *VwapBandStrategy) Name() string {
+	return fmt.Sprintf("VWAP Band Strategy (%v)", v.AnchoredVwap.Multiplier)
+}
+
+// ComputeWithContext processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (v *VwapBandStrategy) ComputeWithContext(ctx context.Context, c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.DuplicateWithContext(ctx, c, 5)
+
+	dates := asset.SnapshotsAsDatesWithContext(ctx, snapshots[0])
+	highs := asset.SnapshotsAsHighsWithContext(ctx, snapshots[1])
+	lows := asset.SnapshotsAsLowsWithContext(ctx, snapshots[2])
+	closings := helper.DuplicateWithContext(ctx, asset.SnapshotsAsClosingsWithContext(ctx, snapshots[3]), 2)
+	volumes := asset.SnapshotsAsVolumesWithContext(ctx, snapshots[4])
+
+	vwaps, uppers, lowers := v.AnchoredVwap.ComputeWithContext(ctx, dates, highs, lows, closings[0], volumes)
+	go helper.DrainWithContext(ctx, vwaps)
+
+	return helper.Operate3WithContext(ctx, closings[1], uppers, lowers, func(closing, upper, lower float64) strategy.Action {
+		if upper <= lower {
+			return strategy.Hold
+		}
+
+		if closing < lower {
+			return strategy.Buy
+		}
+
+		if closing > upper {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (v *VwapBandStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates       -> dates
+	//                 dates       |
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        | -> vwap, upper, lower
+	// snapshots[3] -> closings    |
+	//                 closings    -> closings
+	// snapshots[4] -> volumes     |
+	// snapshots[5] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 6)
+
+	dates := helper.Duplicate(asset.SnapshotsAsDates(snapshots[0]), 2)
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshots[4])
+
+	vwaps, uppers, lowers := v.AnchoredVwap.Compute(dates[1], highs, lows, closings[1], volumes)
+
+	actions, outcomes := strategy.ComputeWithOutcome(v, snapshots[5])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(v.Name(), dates[0])
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("VWAP", vwaps))
+	report.AddColumn(helper.NewNumericReportColumn("Upper", uppers))
+	report.AddColumn(helper.NewNumericReportColumn("Lower", lowers))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (v *VwapBandStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	return v.ComputeWithContext(context.Background(), snapshots)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultAdxPeriod is the default ADX period of 14.
+	DefaultAdxPeriod = 14
+)
+
+// Adx represents the configuration parameters for calculating the Average
+// Directional Index (ADX) along with the Directional Movement Index (DMI).
+// The Plus Directional Indicator (+DI) and the Minus Directional Indicator
+// (-DI) measure the upward and the downward price movements, and the ADX
+// measures the strength of the trend regardless of its direction. The
+// values are smoothed using the Wilder's smoothing (RMA).
+//
+//	Up Move = High - Previous High
+//	Down Move = Previous Low - Low
+//	+DM = Up Move if Up Move > Down Move and Up Move > 0, otherwise 0
+//	-DM = Down Move if Down Move > Up Move and Down Move > 0, otherwise 0
+//	TR = Max(High - Low, Abs(High - Previous Closing), Abs(Low - Previous Closing))
+//	+DI = 100 * RMA(+DM) / RMA(TR)
+//	-DI = 100 * RMA(-DM) / RMA(TR)
+//	DX = 100 * Abs(+DI - -DI) / (+DI + -DI)
+//	ADX = RMA(DX)
+//
+// Example:
+//
+//	adx := trend.NewAdx[float64]()
+//	plusDi, minusDi, dx, adxs := adx.ComputeWithContext(ctx, highs, lows, closings)
+type Adx[T helper.Number] struct {
+	// Period is the smoothing period.
+	Period int
+}
+
+// NewAdx function initializes a new ADX instance with the default parameters.
+func NewAdx[T helper.Number]() *Adx[T] {
+	return NewAdxWithPeriod[T](DefaultAdxPeriod)
+}
+
+// NewAdxWithPeriod function initializes a new ADX instance with the given period.
+func NewAdxWithPeriod[T helper.Number](period int) *Adx[T] {
+	return &Adx[T]{
+		Period: period,
+	}
+}
+
+// adxMove is the directional movement and the true range of a period.
+type adxMove[T helper.Number] struct {
+	PlusDm  T
+	MinusDm T
+	Tr      T
+}
+
+// ComputeWithContext function takes channels of highs, lows, and closings, and
+// computes the +DI, -DI, DX, and ADX over the specified period. All four
+// results are aligned to start after the idle period.
+func (a *Adx[T]) ComputeWithContext(ctx context.Context, highs, lows, closings <-chan T) (<-chan T, <-chan T, <-chan T, <-chan T) {
+	var previousHigh, previousLow, previousClosing T
+
+	moves := helper.Operate3WithContext(ctx, highs, lows, closings, func(high, low, closing T) adxMove[T] {
+		upMove := high - previousHigh
+		downMove := previousLow - low
+
+		move := adxMove[T]{
+			Tr: max(high-low, absT(high-previousClosing), absT(low-previousClosing)),
+		}
+
+		if upMove > downMove && upMove > 0 {
+			move.PlusDm = upMove
+		}
+
+		if downMove > upMove && downMove > 0 {
+			move.MinusDm = downMove
+		}
+
+		previousHigh, previousLow, previousClosing = high, low, closing
+
+		return move
+	})
+
+	// The first period has no previous values.
+	moves = helper.SkipWithContext(ctx, moves, 1)
+
+	movesSplice := helper.DuplicateWithContext(ctx, moves, 3)
+
+	plusDms := NewRmaWithPeriod[T](a.Period).ComputeWithContext(ctx, helper.MapWithContext(ctx, movesSplice[0], func(m adxMove[T]) T {
+		return m.PlusDm
+	}))
+
+	minusDms := NewRmaWithPeriod[T](a.Period).ComputeWithContext(ctx, helper.MapWithContext(ctx, movesSplice[1], func(m adxMove[T]) T {
+		return m.MinusDm
+	}))
+
+	trs := helper.DuplicateWithContext(ctx, NewRmaWithPeriod[T](a.Period).ComputeWithContext(ctx, helper.MapWithContext(ctx, movesSplice[2], func(m adxMove[T]) T {
+		return m.Tr
+	})), 2)
+
+	plusDis := helper.DuplicateWithContext(ctx, helper.OperateWithContext(ctx, plusDms, trs[0], adxRatio[T]), 2)
+	minusDis := helper.DuplicateWithContext(ctx, helper.OperateWithContext(ctx, minusDms, trs[1], adxRatio[T]), 2)
+
+	dxs := helper.DuplicateWithContext(ctx, helper.OperateWithContext(ctx, plusDis[0], minusDis[0], func(plusDi, minusDi T) T {
+		return adxRatio(absT(plusDi-minusDi), plusDi+minusDi)
+	}), 2)
+
+	adxs := NewRmaWithPeriod[T](a.Period).ComputeWithContext(ctx, dxs[0])
+
+	plusDi := helper.SkipWithContext(ctx, plusDis[1], a.Period-1)
+	minusDi := helper.SkipWithContext(ctx, minusDis[1], a.Period-1)
+	dx := helper.SkipWithContext(ctx, dxs[1], a.Period-1)
+
+	return plusDi, minusDi, dx, adxs
+}
+
+// adxRatio returns the given value as a percentage of the given total, or
+// zero if the total is zero.
+func adxRatio[T helper.Number](value, total T) T {
+	if total == 0 {
+		return 0
+	}
+
+	return 100 * value / total
+}
+
+// IdlePeriod is the initial period that ADX won't yield any results.
+func (a *Adx[T]) IdlePeriod() int {
+	return 2*a.Period - 1
+}
+
+// String is the string representation of the ADX.
+func (a *Adx[T]) String() string {
+	return fmt.Sprintf("ADX(%d)", a.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (a *Adx[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T, <-chan T, <-chan T) {
+	return a.ComputeWithContext(context.Background(), highs, lows, closings)
+}
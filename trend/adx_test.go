@@ -0,0 +1,65 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestAdx(t *testing.T) {
+	type Data struct {
+		High    float64
+		Low     float64
+		Close   float64
+		PlusDi  float64
+		MinusDi float64
+		Dx      float64
+		Adx     float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/adx.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adx := trend.NewAdx[float64]()
+
+	inputs := helper.Duplicate(input, 7)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+	expectedPlusDi := helper.Skip(helper.Map(inputs[3], func(d *Data) float64 { return d.PlusDi }), adx.IdlePeriod())
+	expectedMinusDi := helper.Skip(helper.Map(inputs[4], func(d *Data) float64 { return d.MinusDi }), adx.IdlePeriod())
+	expectedDx := helper.Skip(helper.Map(inputs[5], func(d *Data) float64 { return d.Dx }), adx.IdlePeriod())
+	expectedAdx := helper.Skip(helper.Map(inputs[6], func(d *Data) float64 { return d.Adx }), adx.IdlePeriod())
+
+	actualPlusDi, actualMinusDi, actualDx, actualAdx := adx.Compute(highs, lows, closings)
+	actualPlusDi = helper.RoundDigits(actualPlusDi, 2)
+	actualMinusDi = helper.RoundDigits(actualMinusDi, 2)
+	actualDx = helper.RoundDigits(actualDx, 2)
+	actualAdx = helper.RoundDigits(actualAdx, 2)
+
+	err = helper.CheckEquals(
+		actualPlusDi, expectedPlusDi,
+		actualMinusDi, expectedMinusDi,
+		actualDx, expectedDx,
+		actualAdx, expectedAdx,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdxString(t *testing.T) {
+	expected := "ADX(10)"
+	actual := trend.NewAdxWithPeriod[float64](10).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
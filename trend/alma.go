@@ -0,0 +1,115 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultAlmaPeriod is the default ALMA period of 9.
+	DefaultAlmaPeriod = 9
+
+	// DefaultAlmaOffset is the default ALMA offset of 0.85.
+	DefaultAlmaOffset = 0.85
+
+	// DefaultAlmaSigma is the default ALMA sigma of 6.
+	DefaultAlmaSigma = 6
+)
+
+// Alma represents the configuration parameters for calculating the Arnaud
+// Legoux Moving Average (ALMA). It applies a Gaussian weight distribution
+// over the period, where the offset moves the center of the distribution
+// toward the recent values, and the sigma controls its sharpness.
+//
+//	m = Offset * (Period - 1)
+//	s = Period / Sigma
+//	Weight[i] = Exp(-(i - m)^2 / (2 * s^2))
+//	ALMA = Sum(Weight[i] * Value[i]) / Sum(Weight[i])
+//
+// Where i is from 0 for the oldest value to Period - 1 for the most recent value.
+//
+// Example:
+//
+//	alma := trend.NewAlma[float64]()
+//	result := alma.Compute(closings)
+type Alma[T helper.Float] struct {
+	// Period is the time period.
+	Period int
+
+	// Offset is the position of the Gaussian center between 0 and 1.
+	Offset T
+
+	// Sigma is the sharpness of the Gaussian distribution.
+	Sigma T
+}
+
+// NewAlma function initializes a new ALMA instance with the default parameters.
+func NewAlma[T helper.Float]() *Alma[T] {
+	return NewAlmaWith[T](DefaultAlmaPeriod, DefaultAlmaOffset, DefaultAlmaSigma)
+}
+
+// NewAlmaWith function initializes a new ALMA instance with the given parameters.
+func NewAlmaWith[T helper.Float](period int, offset, sigma T) *Alma[T] {
+	return &Alma[T]{
+		Period: period,
+		Offset: offset,
+		Sigma:  sigma,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the ALMA.
+func (a *Alma[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	m := float64(a.Offset) * float64(a.Period-1)
+	s := float64(a.Period) / float64(a.Sigma)
+
+	weights := make([]T, a.Period)
+	var totalWeight T
+
+	for i := range weights {
+		weights[i] = T(math.Exp(-(float64(i) - m) * (float64(i) - m) / (2 * s * s)))
+		totalWeight += weights[i]
+	}
+
+	window := helper.NewRing[T](a.Period)
+
+	almas := helper.MapWithContext(ctx, c, func(value T) T {
+		window.Put(value)
+
+		if !window.IsFull() {
+			return 0
+		}
+
+		var sum T
+		for i, weight := range weights {
+			sum += weight * window.At(i)
+		}
+
+		return sum / totalWeight
+	})
+
+	return helper.SkipWithContext(ctx, almas, a.IdlePeriod())
+}
+
+// IdlePeriod is the initial period that ALMA won't yield any results.
+func (a *Alma[T]) IdlePeriod() int {
+	return a.Period - 1
+}
+
+// String is the string representation of the ALMA.
+func (a *Alma[T]) String() string {
+	return fmt.Sprintf("ALMA(%d,%v,%v)", a.Period, a.Offset, a.Sigma)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (a *Alma[T]) Compute(c <-chan T) <-chan T {
+	return a.ComputeWithContext(context.Background(), c)
+}
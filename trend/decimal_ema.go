@@ -0,0 +1,85 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// DecimalEma represents the parameters for calculating the Exponential Moving
+// Average over fixed-point decimals. The initial EMA value is the SMA, and
+// each following value is rounded to the nearest decimal unit.
+//
+// Example:
+//
+//	ema := trend.NewDecimalEmaWithPeriod(10)
+//	result := ema.ComputeWithContext(ctx, c)
+type DecimalEma struct {
+	// Time period.
+	Period int
+
+	// Smoothing constant.
+	Smoothing helper.Decimal
+}
+
+// NewDecimalEma function initializes a new decimal EMA instance with the default parameters.
+func NewDecimalEma() *DecimalEma {
+	return NewDecimalEmaWithPeriod(DefaultEmaPeriod)
+}
+
+// NewDecimalEmaWithPeriod function initializes a new decimal EMA instance with the given period.
+func NewDecimalEmaWithPeriod(period int) *DecimalEma {
+	return &DecimalEma{
+		Period:    period,
+		Smoothing: helper.NewDecimalFromInt(DefaultEmaSmoothing),
+	}
+}
+
+// ComputeWithContext function takes a channel of decimals and computes the EMA over the specified period, supporting context cancellation.
+func (e *DecimalEma) ComputeWithContext(ctx context.Context, c <-chan helper.Decimal) <-chan helper.Decimal {
+	multiplier := e.Smoothing.Div(helper.NewDecimalFromInt(int64(e.Period + 1)))
+
+	count := 0
+
+	var sum, before helper.Decimal
+
+	emas := helper.MapWithContext(ctx, c, func(n helper.Decimal) helper.Decimal {
+		if count < e.Period {
+			count++
+			sum = sum.Add(n)
+
+			if count == e.Period {
+				before = sum.Div(helper.NewDecimalFromInt(int64(e.Period)))
+			}
+
+			return before
+		}
+
+		before = n.Sub(before).Mul(multiplier).Add(before)
+		return before
+	})
+
+	return helper.SkipWithContext(ctx, emas, e.IdlePeriod())
+}
+
+// IdlePeriod is the initial period that EMA yield any results.
+func (e *DecimalEma) IdlePeriod() int {
+	return e.Period - 1
+}
+
+// String is the string representation of the EMA.
+func (e *DecimalEma) String() string {
+	return fmt.Sprintf("EMA(%d)", e.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (e *DecimalEma) Compute(c <-chan helper.Decimal) <-chan helper.Decimal {
+	return e.ComputeWithContext(context.Background(), c)
+}
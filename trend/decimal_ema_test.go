@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestDecimalEma(t *testing.T) {
+	input := helper.SliceToChan(decimals(decimalSmaInput))
+
+	expected := helper.SliceToChan(decimals(`
+		22.221 22.20809091 22.24116529 22.26640796 22.32887924 22.51635574
+		22.79520015 22.96880012 23.12538191 23.27531247 23.33980111 23.42711
+		23.50763545 23.53351991 23.47106175 23.40359598 23.39021489 23.26108491
+		23.23179674 23.08056097 22.91500443`))
+
+	ema := trend.NewDecimalEmaWithPeriod(10)
+
+	actual := ema.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalEmaString(t *testing.T) {
+	expected := "EMA(20)"
+	actual := trend.NewDecimalEma().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// DecimalSma represents the parameters for calculating the Simple Moving Average
+// over fixed-point decimals. The moving sum is kept exactly, so unlike the float
+// SMA, the result does not drift over long series.
+//
+// Example:
+//
+//	sma := trend.NewDecimalSmaWithPeriod(10)
+//	result := sma.ComputeWithContext(ctx, c)
+type DecimalSma struct {
+	// Period is the time period for the SMA.
+	Period int
+}
+
+// NewDecimalSma function initializes a new decimal SMA instance with the default parameters.
+func NewDecimalSma() *DecimalSma {
+	return NewDecimalSmaWithPeriod(DefaultSmaPeriod)
+}
+
+// NewDecimalSmaWithPeriod function initializes a new decimal SMA instance with the given period.
+func NewDecimalSmaWithPeriod(period int) *DecimalSma {
+	return &DecimalSma{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of decimals and computes the SMA over the specified period.
+func (s *DecimalSma) ComputeWithContext(ctx context.Context, c <-chan helper.Decimal) <-chan helper.Decimal {
+	period := helper.NewDecimalFromInt(int64(s.Period))
+
+	window := make([]helper.Decimal, s.Period)
+	next := 0
+
+	var sum helper.Decimal
+
+	sums := helper.MapWithContext(ctx, c, func(n helper.Decimal) helper.Decimal {
+		sum = sum.Add(n).Sub(window[next])
+		window[next] = n
+		next = (next + 1) % s.Period
+
+		return sum
+	})
+
+	return helper.MapWithContext(ctx, helper.SkipWithContext(ctx, sums, s.IdlePeriod()), func(sum helper.Decimal) helper.Decimal {
+		return sum.Div(period)
+	})
+}
+
+// IdlePeriod is the initial period that SMA won't yield any results.
+func (s *DecimalSma) IdlePeriod() int {
+	return s.Period - 1
+}
+
+// String is the string representation of the SMA.
+func (s *DecimalSma) String() string {
+	return fmt.Sprintf("SMA(%d)", s.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (s *DecimalSma) Compute(c <-chan helper.Decimal) <-chan helper.Decimal {
+	return s.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// decimals parses the given space separated decimals.
+func decimals(s string) []helper.Decimal {
+	var result []helper.Decimal
+	for _, field := range strings.Fields(s) {
+		result = append(result, helper.MustParseDecimal(field))
+	}
+
+	return result
+}
+
+const decimalSmaInput = `
+	22.27 22.19 22.08 22.17 22.18 22.13 22.23 22.43 22.24
+	22.29 22.15 22.39 22.38 22.61 23.36 24.05 23.75 23.83
+	23.95 23.63 23.82 23.87 23.65 23.19 23.10 23.33 22.68
+	23.10 22.40 22.17`
+
+func TestDecimalSma(t *testing.T) {
+	input := helper.SliceToChan(decimals(decimalSmaInput))
+
+	// The exact ties, such as 22.905 and 23.505, are kept exactly.
+	expected := helper.SliceToChan(decimals(`
+		22.221 22.209 22.229 22.259 22.303 22.421 22.613 22.765 22.905
+		23.076 23.21 23.377 23.525 23.652 23.71 23.684 23.612 23.505
+		23.432 23.277 23.131`))
+
+	sma := trend.NewDecimalSmaWithPeriod(10)
+
+	actual := sma.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalSmaString(t *testing.T) {
+	expected := "SMA(50)"
+	actual := trend.NewDecimalSma().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
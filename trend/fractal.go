@@ -0,0 +1,124 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultFractalPeriod is the default number of periods on each side of a fractal.
+	DefaultFractalPeriod = 2
+)
+
+// Fractal represents the configuration parameters for detecting the swing
+// highs and the swing lows with the Williams Fractals. A swing high is a
+// high that is higher than the highs of the given number of periods before
+// and after it, and a swing low is a low that is lower than the lows of the
+// given number of periods before and after it.
+//
+//	Swing High = High[i] > High[i-n..i-1] and High[i] > High[i+1..i+n]
+//	Swing Low = Low[i] < Low[i-n..i-1] and Low[i] < Low[i+1..i+n]
+//
+// The fractals do not repaint. A fractal is confirmed exactly the given number
+// of periods after its pivot, which is its ConfirmedDate, and it never changes
+// afterwards. The pivots within the last periods are not emitted, since they
+// are not confirmed yet.
+//
+// Example:
+//
+//	fractal := trend.NewFractal[float64]()
+//	swings := fractal.ComputeWithContext(ctx, dates, highs, lows)
+type Fractal[T helper.Number] struct {
+	// Period is the number of periods on each side of a fractal.
+	Period int
+}
+
+// NewFractal function initializes a new Fractal instance with the default parameters.
+func NewFractal[T helper.Number]() *Fractal[T] {
+	return NewFractalWithPeriod[T](DefaultFractalPeriod)
+}
+
+// NewFractalWithPeriod function initializes a new Fractal instance with the
+// given number of periods on each side of a fractal.
+func NewFractalWithPeriod[T helper.Number](period int) *Fractal[T] {
+	return &Fractal[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes channels of dates, highs, and lows, and
+// returns a channel of the swings in the order that they are confirmed. When
+// a period is both a swing high and a swing low, the swing high is emitted
+// first.
+func (f *Fractal[T]) ComputeWithContext(ctx context.Context, dates <-chan time.Time, highs, lows <-chan T) <-chan Swing[T] {
+	periods := helper.Operate3WithContext(ctx, dates, highs, lows, func(date time.Time, high, low T) swingPeriod[T] {
+		return swingPeriod[T]{
+			Date: date,
+			High: high,
+			Low:  low,
+		}
+	})
+
+	size := 2*f.Period + 1
+	window := make([]swingPeriod[T], 0, size)
+
+	return detectSwingsWithContext(ctx, periods, func(period swingPeriod[T]) []Swing[T] {
+		if len(window) == size {
+			window = append(window[:0], window[1:]...)
+		}
+
+		window = append(window, period)
+
+		if len(window) < size {
+			return nil
+		}
+
+		pivot := window[f.Period]
+		isHigh, isLow := true, true
+
+		for i, other := range window {
+			if i == f.Period {
+				continue
+			}
+
+			isHigh = isHigh && pivot.High > other.High
+			isLow = isLow && pivot.Low < other.Low
+		}
+
+		var swings []Swing[T]
+
+		if isHigh {
+			swings = append(swings, Swing[T]{Type: SwingHigh, Date: pivot.Date, Price: pivot.High, ConfirmedDate: period.Date})
+		}
+
+		if isLow {
+			swings = append(swings, Swing[T]{Type: SwingLow, Date: pivot.Date, Price: pivot.Low, ConfirmedDate: period.Date})
+		}
+
+		return swings
+	})
+}
+
+// IdlePeriod is the number of periods that a fractal is confirmed after its pivot.
+func (f *Fractal[T]) IdlePeriod() int {
+	return f.Period
+}
+
+// String is the string representation of the Fractal.
+func (f *Fractal[T]) String() string {
+	return fmt.Sprintf("Fractal(%d)", f.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (f *Fractal[T]) Compute(dates <-chan time.Time, highs, lows <-chan T) <-chan Swing[T] {
+	return f.ComputeWithContext(context.Background(), dates, highs, lows)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestFractal(t *testing.T) {
+	highs := []float64{1, 2, 5, 3, 2, 4, 6, 3, 1}
+	lows := helper.ChanToSlice(helper.DecrementBy(helper.SliceToChan(highs), 1))
+
+	expected := []trend.Swing[float64]{
+		{Type: trend.SwingHigh, Date: swingDay(3), Price: 5, ConfirmedDate: swingDay(5)},
+		{Type: trend.SwingLow, Date: swingDay(5), Price: 1, ConfirmedDate: swingDay(7)},
+		{Type: trend.SwingHigh, Date: swingDay(7), Price: 6, ConfirmedDate: swingDay(9)},
+	}
+
+	fractal := trend.NewFractal[float64]()
+
+	actual := helper.ChanToSlice(fractal.Compute(
+		swingDays(len(highs)),
+		helper.SliceToChan(highs),
+		helper.SliceToChan(lows),
+	))
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestFractalIdlePeriod(t *testing.T) {
+	fractal := trend.NewFractal[float64]()
+	fractal.Period = 3
+
+	if fractal.IdlePeriod() != 3 {
+		t.Fatalf("actual %v expected 3", fractal.IdlePeriod())
+	}
+}
+
+func TestFractalString(t *testing.T) {
+	expected := "Fractal(2)"
+	actual := trend.NewFractal[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultFramaPeriod is the default FRAMA period of 16.
+	DefaultFramaPeriod = 16
+)
+
+// Frama represents the configuration parameters for calculating the Ehlers'
+// Fractal Adaptive Moving Average (FRAMA). It measures the fractal dimension
+// of the values over the period, and adapts the alpha of an exponential
+// moving average, so that it follows the trends closely and flattens in the
+// congestion zones.
+//
+//	N1 = (Max - Min of the first half) / (Period / 2)
+//	N2 = (Max - Min of the second half) / (Period / 2)
+//	N3 = (Max - Min of the period) / Period
+//	D = (Log(N1 + N2) - Log(N3)) / Log(2)
+//	Alpha = Exp(-4.6 * (D - 1)), bounded between 0.01 and 1
+//	FRAMA = Alpha * Value + (1 - Alpha) * Previous FRAMA
+//
+// The period must be even. The FRAMA starts with the value at the end of the
+// first full period, and keeps the previous dimension while the ranges are
+// flat.
+//
+// Example:
+//
+//	frama := trend.NewFrama[float64]()
+//	result := frama.Compute(closings)
+type Frama[T helper.Float] struct {
+	// Period is the time period.
+	Period int
+}
+
+// NewFrama function initializes a new FRAMA instance with the default parameters.
+func NewFrama[T helper.Float]() *Frama[T] {
+	return NewFramaWithPeriod[T](DefaultFramaPeriod)
+}
+
+// NewFramaWithPeriod function initializes a new FRAMA instance with the given period.
+func NewFramaWithPeriod[T helper.Float](period int) *Frama[T] {
+	return &Frama[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the FRAMA.
+func (f *Frama[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	half := f.Period / 2
+	window := helper.NewRing[T](f.Period)
+
+	first := true
+	dimension := 1.0
+	var frama T
+
+	framas := helper.MapWithContext(ctx, c, func(value T) T {
+		window.Put(value)
+
+		if !window.IsFull() {
+			return 0
+		}
+
+		if first {
+			first = false
+			frama = value
+			return frama
+		}
+
+		n1 := framaRange(window, 0, half) / T(half)
+		n2 := framaRange(window, half, f.Period) / T(half)
+		n3 := framaRange(window, 0, f.Period) / T(f.Period)
+
+		if n1+n2 > 0 && n3 > 0 {
+			dimension = (math.Log(float64(n1+n2)) - math.Log(float64(n3))) / math.Ln2
+		}
+
+		alpha := T(min(max(math.Exp(-4.6*(dimension-1)), 0.01), 1))
+		frama = alpha*value + (1-alpha)*frama
+
+		return frama
+	})
+
+	return helper.SkipWithContext(ctx, framas, f.IdlePeriod())
+}
+
+// IdlePeriod is the initial period that FRAMA won't yield any results.
+func (f *Frama[T]) IdlePeriod() int {
+	return f.Period - 1
+}
+
+// String is the string representation of the FRAMA.
+func (f *Frama[T]) String() string {
+	return fmt.Sprintf("FRAMA(%d)", f.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (f *Frama[T]) Compute(c <-chan T) <-chan T {
+	return f.ComputeWithContext(context.Background(), c)
+}
+
+// framaRange returns the difference between the highest and the lowest
+// values in the window from the begin index up to the end index.
+func framaRange[T helper.Float](window *helper.Ring[T], begin, end int) T {
+	highest := window.At(begin)
+	lowest := highest
+
+	for i := begin + 1; i < end; i++ {
+		highest = max(highest, window.At(i))
+		lowest = min(lowest, window.At(i))
+	}
+
+	return highest - lowest
+}
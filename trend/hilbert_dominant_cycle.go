@@ -0,0 +1,60 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// HilbertDominantCycle represents the configuration parameters for calculating
+// the Ehlers' Hilbert Transform Dominant Cycle Period. It measures the period
+// of the dominant market cycle, between 6 and 50 periods, through the
+// homodyne discriminator. It is commonly used to adapt the periods of the
+// other indicators to the market.
+//
+//	Period = 360 / ArcTan(Im / Re)
+//	Smooth Period = 0.33 * Period + 0.67 * Previous Smooth Period
+//
+// Example:
+//
+//	dominantCycle := trend.NewHilbertDominantCycle[float64]()
+//	periods := dominantCycle.Compute(closings)
+type HilbertDominantCycle[T helper.Float] struct{}
+
+// NewHilbertDominantCycle function initializes a new Hilbert Dominant Cycle instance.
+func NewHilbertDominantCycle[T helper.Float]() *HilbertDominantCycle[T] {
+	return &HilbertDominantCycle[T]{}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the dominant cycle periods.
+func (h *HilbertDominantCycle[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	hilbert := newHilbertTransform[T]()
+
+	periods := helper.MapWithContext(ctx, c, func(value T) T {
+		hilbert.Next(value)
+		return hilbert.SmoothPeriod
+	})
+
+	return helper.SkipWithContext(ctx, periods, h.IdlePeriod())
+}
+
+// IdlePeriod is the initial period that Hilbert Dominant Cycle won't yield any results.
+func (*HilbertDominantCycle[T]) IdlePeriod() int {
+	return hilbertIdlePeriod
+}
+
+// String is the string representation of the Hilbert Dominant Cycle.
+func (*HilbertDominantCycle[T]) String() string {
+	return "HTDCPERIOD"
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (h *HilbertDominantCycle[T]) Compute(c <-chan T) <-chan T {
+	return h.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestHilbertDominantCycle(t *testing.T) {
+	type Data struct {
+		Close  float64
+		Period float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/hilbert_dominant_cycle.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Period })
+
+	dominantCycle := trend.NewHilbertDominantCycle[float64]()
+	actual := dominantCycle.Compute(closing)
+	actual = helper.RoundDigits(actual, 2)
+
+	expected = helper.Skip(expected, dominantCycle.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHilbertDominantCycleString(t *testing.T) {
+	expected := "HTDCPERIOD"
+	actual := trend.NewHilbertDominantCycle[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// hilbertMinPeriod is the minimum cycle period measured by the Hilbert Transform.
+	hilbertMinPeriod = 6
+
+	// hilbertMaxPeriod is the maximum cycle period measured by the Hilbert Transform.
+	hilbertMaxPeriod = 50
+
+	// hilbertIdlePeriod is the number of periods that the Hilbert Transform
+	// needs to settle, the same as the TA-Lib lookback of its indicators.
+	hilbertIdlePeriod = 32
+)
+
+// hilbertTransform is the Ehlers' Hilbert Transform homodyne discriminator
+// that measures the dominant cycle period and the phase of the values, as
+// described in the Rocket Science for Traders. It is shared by the MAMA and
+// the Hilbert Dominant Cycle indicators. The missing prior values are seeded
+// with the first value.
+type hilbertTransform[T helper.Float] struct {
+	count int
+
+	prices     []T
+	smooths    []T
+	detrenders []T
+	i1s        []T
+	q1s        []T
+
+	i2, q2, re, im T
+
+	// Period is the measured dominant cycle period.
+	Period T
+
+	// SmoothPeriod is the smoothed dominant cycle period.
+	SmoothPeriod T
+
+	// Phase is the phase of the cycle in degrees.
+	Phase T
+
+	// PreviousPhase is the phase of the previous value in degrees.
+	PreviousPhase T
+}
+
+// newHilbertTransform function initializes a new Hilbert Transform instance.
+func newHilbertTransform[T helper.Float]() *hilbertTransform[T] {
+	return &hilbertTransform[T]{
+		prices:     make([]T, 4),
+		smooths:    make([]T, 7),
+		detrenders: make([]T, 7),
+		i1s:        make([]T, 7),
+		q1s:        make([]T, 7),
+	}
+}
+
+// Next function feeds the given value to the Hilbert Transform.
+func (h *hilbertTransform[T]) Next(value T) {
+	h.count++
+
+	if h.count == 1 {
+		for i := range h.prices {
+			h.prices[i] = value
+		}
+	}
+
+	pushEhlers(h.prices, value)
+
+	adjustment := 0.075*h.Period + 0.54
+
+	pushEhlers(h.smooths, (4*h.prices[0]+3*h.prices[1]+2*h.prices[2]+h.prices[3])/10)
+	pushEhlers(h.detrenders, hilbertFir(h.smooths)*adjustment)
+
+	// InPhase and Quadrature components.
+	pushEhlers(h.q1s, hilbertFir(h.detrenders)*adjustment)
+	pushEhlers(h.i1s, h.detrenders[3])
+
+	// Advance the phase of the components by 90 degrees.
+	jI := hilbertFir(h.i1s) * adjustment
+	jQ := hilbertFir(h.q1s) * adjustment
+
+	// Phasor addition for 3 bar averaging.
+	previousI2, previousQ2 := h.i2, h.q2
+	h.i2 = 0.2*(h.i1s[0]-jQ) + 0.8*previousI2
+	h.q2 = 0.2*(h.q1s[0]+jI) + 0.8*previousQ2
+
+	// Homodyne discriminator.
+	h.re = 0.2*(h.i2*previousI2+h.q2*previousQ2) + 0.8*h.re
+	h.im = 0.2*(h.i2*previousQ2-h.q2*previousI2) + 0.8*h.im
+
+	previousPeriod := h.Period
+	period := previousPeriod
+
+	if h.im != 0 && h.re != 0 {
+		period = 360 / degrees(T(math.Atan(float64(h.im/h.re))))
+	}
+
+	period = min(period, 1.5*previousPeriod)
+	period = max(period, 0.67*previousPeriod)
+	period = min(max(period, hilbertMinPeriod), hilbertMaxPeriod)
+
+	h.Period = 0.2*period + 0.8*previousPeriod
+	h.SmoothPeriod = 0.33*h.Period + 0.67*h.SmoothPeriod
+
+	h.PreviousPhase = h.Phase
+	if h.i1s[0] != 0 {
+		h.Phase = degrees(T(math.Atan(float64(h.q1s[0] / h.i1s[0]))))
+	}
+}
+
+// hilbertFir computes the Hilbert Transform FIR filter over the given history.
+func hilbertFir[T helper.Float](history []T) T {
+	return 0.0962*history[0] + 0.5769*history[2] - 0.5769*history[4] - 0.0962*history[6]
+}
+
+// degrees converts the given radians to degrees.
+func degrees[T helper.Float](radians T) T {
+	return radians * 180 / math.Pi
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultInstantaneousTrendlineAlpha is the default Instantaneous Trendline alpha of 0.07.
+	DefaultInstantaneousTrendlineAlpha = 0.07
+)
+
+// InstantaneousTrendline represents the configuration parameters for
+// calculating the Ehlers' Instantaneous Trendline. It removes the dominant
+// cycle component from the values with nearly zero lag, and provides a
+// trigger line that leads the trendline by two periods.
+//
+//	Trendline = (a - a^2 / 4) * Value + 0.5 * a^2 * Previous Value - (a - 0.75 * a^2) * Second Previous Value
+//	    + 2 * (1 - a) * Previous Trendline - (1 - a)^2 * Second Previous Trendline
+//	Trigger = 2 * Trendline - Second Previous Trendline
+//
+// The first six periods use (Value + 2 * Previous Value + Second Previous Value) / 4
+// as the trendline, and the missing prior values are seeded with the first value.
+//
+// Example:
+//
+//	instantaneousTrendline := trend.NewInstantaneousTrendline[float64]()
+//	trendlines, triggers := instantaneousTrendline.Compute(closings)
+type InstantaneousTrendline[T helper.Float] struct {
+	// Alpha is the smoothing factor.
+	Alpha T
+}
+
+// NewInstantaneousTrendline function initializes a new Instantaneous Trendline instance with the default parameters.
+func NewInstantaneousTrendline[T helper.Float]() *InstantaneousTrendline[T] {
+	return NewInstantaneousTrendlineWithAlpha[T](DefaultInstantaneousTrendlineAlpha)
+}
+
+// NewInstantaneousTrendlineWithAlpha function initializes a new Instantaneous Trendline instance with the given alpha.
+func NewInstantaneousTrendlineWithAlpha[T helper.Float](alpha T) *InstantaneousTrendline[T] {
+	return &InstantaneousTrendline[T]{
+		Alpha: alpha,
+	}
+}
+
+// instantaneousTrendlineResult is the trendline and the trigger of a period.
+type instantaneousTrendlineResult[T helper.Float] struct {
+	Trendline T
+	Trigger   T
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the trendline and the trigger.
+func (i *InstantaneousTrendline[T]) ComputeWithContext(ctx context.Context, c <-chan T) (<-chan T, <-chan T) {
+	a := i.Alpha
+
+	count := 0
+	values := make([]T, 3)
+	trendlines := make([]T, 3)
+
+	results := helper.MapWithContext(ctx, c, func(value T) instantaneousTrendlineResult[T] {
+		count++
+
+		if count == 1 {
+			for j := range values {
+				values[j] = value
+				trendlines[j] = value
+			}
+		}
+
+		pushEhlers(values, value)
+
+		var trendline T
+		if count < 7 {
+			trendline = (values[0] + 2*values[1] + values[2]) / 4
+		} else {
+			trendline = (a-a*a/4)*values[0] + 0.5*a*a*values[1] - (a-0.75*a*a)*values[2] +
+				2*(1-a)*trendlines[0] - (1-a)*(1-a)*trendlines[1]
+		}
+
+		pushEhlers(trendlines, trendline)
+
+		return instantaneousTrendlineResult[T]{
+			Trendline: trendline,
+			Trigger:   2*trendline - trendlines[2],
+		}
+	})
+
+	resultsSplice := helper.DuplicateWithContext(ctx, results, 2)
+
+	trendlinesResult := helper.MapWithContext(ctx, resultsSplice[0], func(r instantaneousTrendlineResult[T]) T {
+		return r.Trendline
+	})
+
+	triggers := helper.MapWithContext(ctx, resultsSplice[1], func(r instantaneousTrendlineResult[T]) T {
+		return r.Trigger
+	})
+
+	return trendlinesResult, triggers
+}
+
+// IdlePeriod is the initial period that Instantaneous Trendline won't yield any results.
+func (*InstantaneousTrendline[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Instantaneous Trendline.
+func (i *InstantaneousTrendline[T]) String() string {
+	return fmt.Sprintf("ITL(%v)", i.Alpha)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (i *InstantaneousTrendline[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
+	return i.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestInstantaneousTrendline(t *testing.T) {
+	type Data struct {
+		Close     float64
+		Trendline float64
+		Trigger   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/instantaneous_trendline.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 3)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expectedTrendline := helper.Map(inputs[1], func(d *Data) float64 { return d.Trendline })
+	expectedTrigger := helper.Map(inputs[2], func(d *Data) float64 { return d.Trigger })
+
+	instantaneousTrendline := trend.NewInstantaneousTrendline[float64]()
+	actualTrendline, actualTrigger := instantaneousTrendline.Compute(closing)
+	actualTrendline = helper.RoundDigits(actualTrendline, 2)
+	actualTrigger = helper.RoundDigits(actualTrigger, 2)
+
+	err = helper.CheckEquals(actualTrendline, expectedTrendline, actualTrigger, expectedTrigger)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInstantaneousTrendlineString(t *testing.T) {
+	expected := "ITL(0.07)"
+	actual := trend.NewInstantaneousTrendline[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
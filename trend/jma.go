@@ -0,0 +1,111 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultJmaPeriod is the default JMA period of 7.
+	DefaultJmaPeriod = 7
+
+	// DefaultJmaPhase is the default JMA phase of 50.
+	DefaultJmaPhase = 50
+
+	// DefaultJmaPower is the default JMA power of 2.
+	DefaultJmaPower = 2
+)
+
+// Jma represents the configuration parameters for calculating a Jurik-style
+// adaptive moving average (JMA). The original Jurik Moving Average is
+// proprietary, and this is the widely used public approximation of it, which
+// chains an adaptive EMA, a Kalman style filter, and a Jurik adaptive filter
+// to follow the values closely with low lag and little overshoot. The phase
+// between -100 and 100 trades the lag for the overshoot.
+//
+//	Beta = 0.45 * (Period - 1) / (0.45 * (Period - 1) + 2)
+//	Alpha = Beta ^ Power
+//	Phase Ratio = Phase / 100 + 1.5, bounded between 0.5 and 2.5
+//	E0 = (1 - Alpha) * Value + Alpha * Previous E0
+//	E1 = (Value - E0) * (1 - Beta) + Beta * Previous E1
+//	E2 = (E0 + Phase Ratio * E1 - Previous JMA) * (1 - Alpha)^2 + Alpha^2 * Previous E2
+//	JMA = E2 + Previous JMA
+//
+// The E0 and the JMA start with the first value.
+//
+// Example:
+//
+//	jma := trend.NewJma[float64]()
+//	result := jma.Compute(closings)
+type Jma[T helper.Float] struct {
+	// Period is the time period.
+	Period int
+
+	// Phase is the phase between -100 and 100.
+	Phase T
+
+	// Power is the power of the alpha.
+	Power T
+}
+
+// NewJma function initializes a new JMA instance with the default parameters.
+func NewJma[T helper.Float]() *Jma[T] {
+	return NewJmaWith[T](DefaultJmaPeriod, DefaultJmaPhase, DefaultJmaPower)
+}
+
+// NewJmaWith function initializes a new JMA instance with the given parameters.
+func NewJmaWith[T helper.Float](period int, phase, power T) *Jma[T] {
+	return &Jma[T]{
+		Period: period,
+		Phase:  phase,
+		Power:  power,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the JMA.
+func (j *Jma[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	beta := T(0.45*float64(j.Period-1)) / T(0.45*float64(j.Period-1)+2)
+	alpha := T(math.Pow(float64(beta), float64(j.Power)))
+	phaseRatio := min(max(j.Phase/100+1.5, 0.5), 2.5)
+
+	first := true
+	var e0, e1, e2, jma T
+
+	return helper.MapWithContext(ctx, c, func(value T) T {
+		if first {
+			first = false
+			e0, jma = value, value
+		}
+
+		e0 = (1-alpha)*value + alpha*e0
+		e1 = (value-e0)*(1-beta) + beta*e1
+		e2 = (e0+phaseRatio*e1-jma)*(1-alpha)*(1-alpha) + alpha*alpha*e2
+		jma = e2 + jma
+
+		return jma
+	})
+}
+
+// IdlePeriod is the initial period that JMA won't yield any results.
+func (*Jma[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the JMA.
+func (j *Jma[T]) String() string {
+	return fmt.Sprintf("JMA(%d,%v,%v)", j.Period, j.Phase, j.Power)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (j *Jma[T]) Compute(c <-chan T) <-chan T {
+	return j.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultLsmaPeriod is the default LSMA period of 25.
+	DefaultLsmaPeriod = 25
+)
+
+// Lsma represents the configuration parameters for calculating the Least
+// Squares Moving Average (LSMA), also known as the linear regression
+// endpoint. It fits a least squares line over the period using the Moving
+// Least Square (MLS), and takes the value of the line at the most recent
+// period.
+//
+//	m, b = MLS(period, x, values)
+//	LSMA = m * x + b
+//
+// Where x is the index of the value.
+//
+// Example:
+//
+//	lsma := trend.NewLsma[float64]()
+//	result := lsma.Compute(closings)
+type Lsma[T helper.Float] struct {
+	// Period is the time period, which must be at least 2.
+	Period int
+}
+
+// NewLsma function initializes a new LSMA instance with the default parameters.
+func NewLsma[T helper.Float]() *Lsma[T] {
+	return NewLsmaWithPeriod[T](DefaultLsmaPeriod)
+}
+
+// NewLsmaWithPeriod function initializes a new LSMA instance with the given period.
+func NewLsmaWithPeriod[T helper.Float](period int) *Lsma[T] {
+	return &Lsma[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the LSMA.
+func (l *Lsma[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	mls := NewMlsWithPeriod[T](l.Period)
+
+	cSplice := helper.DuplicateWithContext(ctx, c, 2)
+
+	xSplice := helper.DuplicateWithContext(ctx, helper.CountWithContext(ctx, T(0), cSplice[0]),
+		2,
+	)
+
+	ms, bs := mls.ComputeWithContext(ctx, xSplice[0], cSplice[1])
+
+	xSplice[1] = helper.SkipWithContext(ctx, xSplice[1], mls.IdlePeriod())
+
+	// LSMA = m * x + b
+	lsmas := helper.AddWithContext(ctx, helper.MultiplyWithContext(ctx, ms,
+		xSplice[1],
+	),
+		bs,
+	)
+
+	return lsmas
+}
+
+// IdlePeriod is the initial period that LSMA won't yield any results.
+func (l *Lsma[T]) IdlePeriod() int {
+	return l.Period - 1
+}
+
+// String is the string representation of the LSMA.
+func (l *Lsma[T]) String() string {
+	return fmt.Sprintf("LSMA(%d)", l.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (l *Lsma[T]) Compute(c <-chan T) <-chan T {
+	return l.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// maBuilder builds a moving average with a period, after checking the period
+// against the requirements of the moving average, if there are any.
+type maBuilder[T helper.Float] struct {
+	// build initializes the moving average with the given period.
+	build func(period int) Ma[T]
+
+	// validate checks the given period beyond being greater than 0.
+	validate func(name string, period int) error
+}
+
+// maBuilders returns the builders of the moving averages that can be
+// initialized by their names with a period.
+func maBuilders[T helper.Float]() map[string]maBuilder[T] {
+	return map[string]maBuilder[T]{
+		"alma": {build: func(period int) Ma[T] {
+			return NewAlmaWith[T](period, DefaultAlmaOffset, DefaultAlmaSigma)
+		}},
+		"dema": {build: func(period int) Ma[T] {
+			dema := NewDema[T]()
+			dema.Ema1.Period = period
+			dema.Ema2.Period = period
+			return dema
+		}},
+		"ema": {build: func(period int) Ma[T] {
+			return NewEmaWithPeriod[T](period)
+		}},
+		"frama": {
+			build: func(period int) Ma[T] {
+				return NewFramaWithPeriod[T](period)
+			},
+			validate: evenMaPeriod,
+		},
+		"hma": {build: func(period int) Ma[T] {
+			return NewHmaWithPeriod[T](period)
+		}},
+		"jma": {build: func(period int) Ma[T] {
+			return NewJmaWith[T](period, DefaultJmaPhase, DefaultJmaPower)
+		}},
+		"kama": {build: func(period int) Ma[T] {
+			return NewKamaWith[T](period, DefaultKamaFastScPeriod, DefaultKamaSlowScPeriod)
+		}},
+		"lsma": {
+			build: func(period int) Ma[T] {
+				return NewLsmaWithPeriod[T](period)
+			},
+			validate: minMaPeriod(2),
+		},
+		"mcginley_dynamic": {build: func(period int) Ma[T] {
+			return NewMcGinleyDynamicWithPeriod[T](period)
+		}},
+		"rma": {build: func(period int) Ma[T] {
+			return NewRmaWithPeriod[T](period)
+		}},
+		"sma": {build: func(period int) Ma[T] {
+			return NewSmaWithPeriod[T](period)
+		}},
+		"smma": {build: func(period int) Ma[T] {
+			return NewSmmaWithPeriod[T](period)
+		}},
+		"super_smoother": {build: func(period int) Ma[T] {
+			return NewSuperSmootherWithPeriod[T](period)
+		}},
+		"t3": {build: func(period int) Ma[T] {
+			return NewT3WithPeriodAndFactor[T](period, DefaultT3VolumeFactor)
+		}},
+		"tema": {build: func(period int) Ma[T] {
+			tema := NewTema[T]()
+			tema.Ema1.Period = period
+			tema.Ema2.Period = period
+			tema.Ema3.Period = period
+			return tema
+		}},
+		"trima": {build: func(period int) Ma[T] {
+			trima := NewTrima[T]()
+			trima.Period = period
+			return trima
+		}},
+		"vidya": {build: func(period int) Ma[T] {
+			return NewVidyaWithPeriods[T](period, DefaultVidyaCmoPeriod)
+		}},
+		"wma": {build: func(period int) Ma[T] {
+			return NewWmaWith[T](period)
+		}},
+		"zlema": {build: func(period int) Ma[T] {
+			return NewZlemaWithPeriod[T](period)
+		}},
+	}
+}
+
+// NewMaByName function initializes a new moving average instance with the
+// given name, such as "ema" or "alma", and the given period. The other
+// parameters of the moving average take their default values. The name is
+// case insensitive. The LSMA requires a period of at least 2, and the FRAMA
+// requires an even period.
+//
+// Example:
+//
+//	ma, err := trend.NewMaByName[float64]("zlema", 20)
+//	if err != nil {
+//		return err
+//	}
+//
+//	result := ma.Compute(closings)
+func NewMaByName[T helper.Float](name string, period int) (Ma[T], error) {
+	builder, ok := maBuilders[T]()[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown moving average: %s", name)
+	}
+
+	if period <= 0 {
+		return nil, fmt.Errorf("period must be greater than 0 for %s", name)
+	}
+
+	if builder.validate != nil {
+		err := builder.validate(name, period)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.build(period), nil
+}
+
+// minMaPeriod returns a validator requiring a period of at least the given
+// minimum.
+func minMaPeriod(minimum int) func(name string, period int) error {
+	return func(name string, period int) error {
+		if period < minimum {
+			return fmt.Errorf("period must be at least %d for %s", minimum, name)
+		}
+
+		return nil
+	}
+}
+
+// evenMaPeriod requires an even period.
+func evenMaPeriod(name string, period int) error {
+	if period%2 != 0 {
+		return fmt.Errorf("period must be even for %s", name)
+	}
+
+	return nil
+}
+
+// MaNames returns the sorted names of the moving averages that can be
+// initialized through the NewMaByName function.
+func MaNames() []string {
+	names := make([]string, 0)
+	for name := range maBuilders[float64]() {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestNewMaByName(t *testing.T) {
+	tests := map[string]string{
+		"alma":             "ALMA(10,0.85,6)",
+		"dema":             "DEMA(10)",
+		"EMA":              "EMA(10)",
+		"frama":            "FRAMA(10)",
+		"jma":              "JMA(10,50,2)",
+		"lsma":             "LSMA(10)",
+		"rma":              "RMA(10)",
+		"sma":              "SMA(10)",
+		"super_smoother":   "SS(10)",
+		"tema":             "TEMA(10)",
+		"trima":            "TRIMA(10)",
+		"vidya":            "VIDYA(10,9)",
+		"wma":              "WMA(10)",
+		"zlema":            "ZLEMA(10)",
+		"mcginley_dynamic": trend.NewMcGinleyDynamicWithPeriod[float64](10).String(),
+	}
+
+	for name, expected := range tests {
+		ma, err := trend.NewMaByName[float64](name, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := ma.String()
+		if actual != expected {
+			t.Fatalf("%s actual %v expected %v", name, actual, expected)
+		}
+	}
+}
+
+func TestNewMaByNameCompute(t *testing.T) {
+	values := make([]float64, 100)
+	for i := range values {
+		values[i] = float64(100 + i%10)
+	}
+
+	for _, name := range trend.MaNames() {
+		ma, err := trend.NewMaByName[float64](name, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := len(helper.ChanToSlice(ma.Compute(helper.SliceToChan(values))))
+		expected := len(values) - ma.IdlePeriod()
+
+		if actual != expected {
+			t.Fatalf("%s actual %v expected %v", name, actual, expected)
+		}
+	}
+}
+
+func TestNewMaByNameUnknown(t *testing.T) {
+	_, err := trend.NewMaByName[float64]("unknown", 10)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewMaByNameInvalidPeriod(t *testing.T) {
+	_, err := trend.NewMaByName[float64]("sma", 0)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMaNames(t *testing.T) {
+	names := trend.MaNames()
+
+	if len(names) != 19 {
+		t.Fatalf("actual %v expected 19 names", len(names))
+	}
+
+	for i := 1; i < len(names); i++ {
+		if names[i-1] >= names[i] {
+			t.Fatalf("names are not sorted: %v", names)
+		}
+	}
+}
+
+func TestNewMaByNameOddFramaPeriod(t *testing.T) {
+	_, err := trend.NewMaByName[float64]("frama", 15)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewMaByNameLsmaPeriod(t *testing.T) {
+	_, err := trend.NewMaByName[float64]("lsma", 1)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	ma, err := trend.NewMaByName[float64]("lsma", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, value := range helper.ChanToSlice(ma.Compute(helper.SliceToChan([]float64{1, 2, 4, 3}))) {
+		if math.IsNaN(value) {
+			t.Fatal("expected no NaN")
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMamaFastLimit is the default MAMA fast limit of 0.5.
+	DefaultMamaFastLimit = 0.5
+
+	// DefaultMamaSlowLimit is the default MAMA slow limit of 0.05.
+	DefaultMamaSlowLimit = 0.05
+)
+
+// Mama represents the configuration parameters for calculating the Ehlers'
+// MESA Adaptive Moving Average (MAMA) and the Following Adaptive Moving
+// Average (FAMA). The alpha of the moving averages adapts to the rate of
+// change of the phase measured by the Hilbert Transform.
+//
+//	Delta Phase = Max(Previous Phase - Phase, 1)
+//	Alpha = Max(Fast Limit / Delta Phase, Slow Limit)
+//	MAMA = Alpha * Value + (1 - Alpha) * Previous MAMA
+//	FAMA = 0.5 * Alpha * MAMA + (1 - 0.5 * Alpha) * Previous FAMA
+//
+// Both moving averages start with the first value, and the results are
+// yielded once the Hilbert Transform settles.
+//
+// Example:
+//
+//	mama := trend.NewMama[float64]()
+//	mamas, famas := mama.Compute(closings)
+type Mama[T helper.Float] struct {
+	// FastLimit is the maximum alpha.
+	FastLimit T
+
+	// SlowLimit is the minimum alpha.
+	SlowLimit T
+}
+
+// NewMama function initializes a new MAMA instance with the default parameters.
+func NewMama[T helper.Float]() *Mama[T] {
+	return NewMamaWithLimits[T](DefaultMamaFastLimit, DefaultMamaSlowLimit)
+}
+
+// NewMamaWithLimits function initializes a new MAMA instance with the given limits.
+func NewMamaWithLimits[T helper.Float](fastLimit, slowLimit T) *Mama[T] {
+	return &Mama[T]{
+		FastLimit: fastLimit,
+		SlowLimit: slowLimit,
+	}
+}
+
+// mamaResult is the MAMA and the FAMA of a period.
+type mamaResult[T helper.Float] struct {
+	Mama T
+	Fama T
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the MAMA and the FAMA.
+func (m *Mama[T]) ComputeWithContext(ctx context.Context, c <-chan T) (<-chan T, <-chan T) {
+	hilbert := newHilbertTransform[T]()
+	var result mamaResult[T]
+
+	results := helper.MapWithContext(ctx, c, func(value T) mamaResult[T] {
+		hilbert.Next(value)
+
+		if hilbert.count == 1 {
+			result = mamaResult[T]{Mama: value, Fama: value}
+		}
+
+		deltaPhase := max(hilbert.PreviousPhase-hilbert.Phase, 1)
+		alpha := max(m.FastLimit/deltaPhase, m.SlowLimit)
+
+		result.Mama = alpha*value + (1-alpha)*result.Mama
+		result.Fama = 0.5*alpha*result.Mama + (1-0.5*alpha)*result.Fama
+
+		return result
+	})
+
+	resultsSplice := helper.DuplicateWithContext(ctx, helper.SkipWithContext(ctx, results, m.IdlePeriod()), 2)
+
+	mamas := helper.MapWithContext(ctx, resultsSplice[0], func(r mamaResult[T]) T {
+		return r.Mama
+	})
+
+	famas := helper.MapWithContext(ctx, resultsSplice[1], func(r mamaResult[T]) T {
+		return r.Fama
+	})
+
+	return mamas, famas
+}
+
+// IdlePeriod is the initial period that MAMA won't yield any results.
+func (*Mama[T]) IdlePeriod() int {
+	return hilbertIdlePeriod
+}
+
+// String is the string representation of the MAMA.
+func (m *Mama[T]) String() string {
+	return fmt.Sprintf("MAMA(%v,%v)", m.FastLimit, m.SlowLimit)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *Mama[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
+	return m.ComputeWithContext(context.Background(), c)
+}
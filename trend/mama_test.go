@@ -0,0 +1,52 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestMama(t *testing.T) {
+	type Data struct {
+		Close float64
+		Mama  float64
+		Fama  float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/mama.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 3)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expectedMama := helper.Map(inputs[1], func(d *Data) float64 { return d.Mama })
+	expectedFama := helper.Map(inputs[2], func(d *Data) float64 { return d.Fama })
+
+	mama := trend.NewMama[float64]()
+	actualMama, actualFama := mama.Compute(closing)
+	actualMama = helper.RoundDigits(actualMama, 2)
+	actualFama = helper.RoundDigits(actualFama, 2)
+
+	expectedMama = helper.Skip(expectedMama, mama.IdlePeriod())
+	expectedFama = helper.Skip(expectedFama, mama.IdlePeriod())
+
+	err = helper.CheckEquals(actualMama, expectedMama, actualFama, expectedFama)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMamaString(t *testing.T) {
+	expected := "MAMA(0.5,0.05)"
+	actual := trend.NewMama[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
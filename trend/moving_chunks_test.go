@@ -0,0 +1,45 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestMovingChunks(t *testing.T) {
+	ctx := context.Background()
+
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i] = 100 + 10*math.Sin(float64(i)/10) + float64(i%7)
+	}
+
+	values[500] = 1e12
+
+	indicators := []helper.ChunkedIndicator[float64]{
+		trend.NewMovingSumWithPeriod[float64](20),
+		trend.NewSmaWithPeriod[float64](20),
+		trend.NewMovingMaxWithPeriod[float64](20),
+		trend.NewMovingMinWithPeriod[float64](20),
+		trend.NewMovingMedianWithPeriod[float64](20),
+	}
+
+	for _, indicator := range indicators {
+		for _, size := range []int{1, 7, 256} {
+			expected := indicator.ComputeWithContext(ctx, helper.SliceToChan(values))
+			actual := helper.UnchunkWithContext(ctx, indicator.ComputeChunksWithContext(ctx, helper.SliceToChunks(values, size)))
+
+			err := helper.CheckEquals(actual, expected)
+			if err != nil {
+				t.Fatalf("%T chunk size %d: %v", indicator, size, err)
+			}
+		}
+	}
+}
@@ -34,18 +34,18 @@ func NewMovingMaxWithPeriod[T helper.Number](period int) *MovingMax[T] {
 // ComputeWithContext function takes a channel of numbers and computes the
 // Moving Max over the specified period.
 func (m *MovingMax[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
-	cs := helper.DuplicateWithContext(ctx, c, 2)
-	cs[1] = helper.ShiftWithContext(ctx, cs[1], m.Period, 0)
-
-	bst := helper.NewBst[T]()
-
-	maxs := helper.OperateWithContext(ctx, cs[0], cs[1], func(c, b T) T {
-		bst.Insert(c)
-		bst.Remove(b)
-		return bst.Max()
+	return helper.MovingOrderStatisticsWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Max()
 	})
+}
 
-	return helper.SkipWithContext(ctx, maxs, m.Period-1)
+// ComputeChunksWithContext function takes a channel of chunks of numbers and
+// computes the Moving Max over the specified period, returning the chunks of
+// the results.
+func (m *MovingMax[T]) ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T {
+	return helper.MovingOrderStatisticsChunksWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Max()
+	})
 }
 
 // IdlePeriod is the initial period that Mocing Max won't yield any results.
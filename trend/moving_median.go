@@ -0,0 +1,77 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingMedianPeriod is the default period for the Moving Median.
+	DefaultMovingMedianPeriod = 20
+)
+
+// MovingMedian represents the configuration parameters for calculating the
+// Moving Median over the specified period. The values of the period are kept
+// in an order statistics tree, so each update takes O(log n) time. For an
+// even period, the median is the average of the two middle values.
+//
+// Example:
+//
+//	median := trend.NewMovingMedianWithPeriod[float64](10)
+//	result := median.ComputeWithContext(ctx, c)
+type MovingMedian[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingMedian function initializes a new Moving Median instance with the default parameters.
+func NewMovingMedian[T helper.Number]() *MovingMedian[T] {
+	return NewMovingMedianWithPeriod[T](DefaultMovingMedianPeriod)
+}
+
+// NewMovingMedianWithPeriod function initializes a new Moving Median instance with the given period.
+func NewMovingMedianWithPeriod[T helper.Number](period int) *MovingMedian[T] {
+	return &MovingMedian[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Moving Median over the specified period, supporting context cancellation.
+func (m *MovingMedian[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return helper.MovingOrderStatisticsWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Median()
+	})
+}
+
+// ComputeChunksWithContext function takes a channel of chunks of numbers and
+// computes the Moving Median over the specified period, returning the chunks of
+// the results.
+func (m *MovingMedian[T]) ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T {
+	return helper.MovingOrderStatisticsChunksWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Median()
+	})
+}
+
+// IdlePeriod is the initial period that Moving Median won't yield any results.
+func (m *MovingMedian[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Median.
+func (m *MovingMedian[T]) String() string {
+	return fmt.Sprintf("MMED(%d)", m.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingMedian[T]) Compute(c <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestMovingMedian(t *testing.T) {
+	input := helper.SliceToChan([]float64{5, 1, 4, 2, 3, 0})
+	expected := helper.SliceToChan([]float64{4, 2, 3, 2})
+
+	median := trend.NewMovingMedianWithPeriod[float64](3)
+
+	actual := median.ComputeWithContext(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if median.IdlePeriod() != 2 {
+		t.Fatalf("actual %v expected 2", median.IdlePeriod())
+	}
+}
+
+func TestMovingMedianEvenPeriod(t *testing.T) {
+	input := helper.SliceToChan([]float64{5, 1, 4, 2, 3})
+	expected := helper.SliceToChan([]float64{3, 2.5})
+
+	median := trend.NewMovingMedianWithPeriod[float64](4)
+
+	actual := median.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMovingMedianString(t *testing.T) {
+	expected := "MMED(20)"
+	actual := trend.NewMovingMedian[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
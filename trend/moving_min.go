@@ -34,18 +34,18 @@ func NewMovingMinWithPeriod[T helper.Number](period int) *MovingMin[T] {
 // ComputeWithContext function takes a channel of numbers and computes the
 // Moving Min over the specified period.
 func (m *MovingMin[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
-	cs := helper.DuplicateWithContext(ctx, c, 2)
-	cs[1] = helper.ShiftWithContext(ctx, cs[1], m.Period, 0)
-
-	bst := helper.NewBst[T]()
-
-	mins := helper.OperateWithContext(ctx, cs[0], cs[1], func(c, b T) T {
-		bst.Insert(c)
-		bst.Remove(b)
-		return bst.Min()
+	return helper.MovingOrderStatisticsWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Min()
 	})
+}
 
-	return helper.SkipWithContext(ctx, mins, m.Period-1)
+// ComputeChunksWithContext function takes a channel of chunks of numbers and
+// computes the Moving Min over the specified period, returning the chunks of
+// the results.
+func (m *MovingMin[T]) ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T {
+	return helper.MovingOrderStatisticsChunksWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Min()
+	})
 }
 
 // IdlePeriod is the initial period that Mocing Min won't yield any results.
@@ -23,3 +23,16 @@ func TestMovingMin(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMovingMinWithZeros(t *testing.T) {
+	input := helper.SliceToChan([]int{0, 5, 6, 0})
+	expected := helper.SliceToChan([]int{0, 5, 0})
+
+	movingMin := trend.NewMovingMinWithPeriod[int](2)
+	actual := movingMin.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
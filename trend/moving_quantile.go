@@ -0,0 +1,82 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingQuantilePeriod is the default period for the Moving Quantile.
+	DefaultMovingQuantilePeriod = 20
+
+	// DefaultMovingQuantile is the default quantile for the Moving Quantile.
+	DefaultMovingQuantile = 0.5
+)
+
+// MovingQuantile represents the configuration parameters for calculating the
+// Moving Quantile over the specified period. The quantile is between 0 and 1,
+// and it is linearly interpolated between the closest ranks. The values of the
+// period are kept in an order statistics tree, so each update takes O(log n)
+// time.
+//
+// Example:
+//
+//	quantile := trend.NewMovingQuantileWithPeriod[float64](20, 0.9)
+//	result := quantile.ComputeWithContext(ctx, c)
+type MovingQuantile[T helper.Number] struct {
+	// Time period.
+	Period int
+
+	// Quantile between 0 and 1.
+	Quantile float64
+}
+
+// NewMovingQuantile function initializes a new Moving Quantile instance with the default parameters.
+func NewMovingQuantile[T helper.Number]() *MovingQuantile[T] {
+	return NewMovingQuantileWithPeriod[T](DefaultMovingQuantilePeriod, DefaultMovingQuantile)
+}
+
+// NewMovingQuantileWithPeriod function initializes a new Moving Quantile instance with the given period and quantile.
+func NewMovingQuantileWithPeriod[T helper.Number](period int, quantile float64) *MovingQuantile[T] {
+	return &MovingQuantile[T]{
+		Period:   period,
+		Quantile: quantile,
+	}
+}
+
+// NewMovingPercentileWithPeriod function initializes a new Moving Quantile instance computing
+// the given percentile, between 0 and 100, over the given period.
+func NewMovingPercentileWithPeriod[T helper.Number](period int, percentile float64) *MovingQuantile[T] {
+	return NewMovingQuantileWithPeriod[T](period, percentile/100)
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Moving Quantile over the specified period, supporting context cancellation.
+func (m *MovingQuantile[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return helper.MovingOrderStatisticsWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.Quantile(m.Quantile)
+	})
+}
+
+// IdlePeriod is the initial period that Moving Quantile won't yield any results.
+func (m *MovingQuantile[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Quantile.
+func (m *MovingQuantile[T]) String() string {
+	return fmt.Sprintf("MQUANTILE(%d,%v)", m.Period, m.Quantile)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingQuantile[T]) Compute(c <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), c)
+}
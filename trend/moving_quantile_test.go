@@ -0,0 +1,50 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestMovingQuantile(t *testing.T) {
+	input := helper.SliceToChan([]float64{1, 2, 3, 4, 5, 6})
+	expected := helper.SliceToChan([]float64{1.75, 2.75, 3.75})
+
+	quantile := trend.NewMovingQuantileWithPeriod[float64](4, 0.25)
+
+	actual := quantile.ComputeWithContext(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMovingPercentile(t *testing.T) {
+	input := helper.SliceToChan([]float64{6, 5, 4, 3, 2, 1})
+	expected := helper.SliceToChan([]float64{5.25, 4.25, 3.25})
+
+	percentile := trend.NewMovingPercentileWithPeriod[float64](4, 75)
+
+	actual := percentile.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMovingQuantileString(t *testing.T) {
+	expected := "MQUANTILE(20,0.5)"
+	actual := trend.NewMovingQuantile[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -55,9 +55,49 @@ func NewMovingSumWithPeriod[T helper.Number](period int) *MovingSum[T] {
 // the bad value actually leaves the window, instead of staying NaN/Inf for
 // the rest of the series.
 func (m *MovingSum[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
-	cs := helper.DuplicateWithContext(ctx, c, 2)
-	cs[1] = helper.ShiftWithContext(ctx, cs[1], m.Period, 0)
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		step := m.step()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n, ok := <-c:
+				if !ok {
+					return
+				}
+
+				sum, ok := step(n)
+				if !ok {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case result <- sum:
+				}
+			}
+		}
+	}()
+
+	return result
+}
 
+// ComputeChunksWithContext function takes a channel of chunks of numbers and
+// computes the Moving Sum over the specified period, returning the chunks of
+// the results. It gives the same results as ComputeWithContext.
+func (m *MovingSum[T]) ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T {
+	return helper.FilterMapChunksWithContext(ctx, c, m.step())
+}
+
+// step returns a function that adds the given value to the moving window, and
+// returns the sum once the window is full.
+func (m *MovingSum[T]) step() func(T) (T, bool) {
 	sum := T(0)
 	comp := T(0)
 
@@ -65,15 +105,16 @@ func (m *MovingSum[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-cha
 	next := 0
 	filled := 0
 
-	sums := helper.OperateWithContext(ctx, cs[0], cs[1], func(c, b T) T {
-		window[next] = c
+	return func(n T) (T, bool) {
+		b := window[next]
+		window[next] = n
 		next = (next + 1) % m.Period
 
 		if filled < m.Period {
 			filled++
 		}
 
-		sum, comp = neumaierAdd(sum, comp, c)
+		sum, comp = neumaierAdd(sum, comp, n)
 		sum, comp = neumaierAdd(sum, comp, -b)
 
 		result := sum + comp
@@ -88,10 +129,8 @@ func (m *MovingSum[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-cha
 			result = sum + comp
 		}
 
-		return result
-	})
-
-	return helper.SkipWithContext(ctx, sums, m.Period-1)
+		return result, filled == m.Period
+	}
 }
 
 // neumaierAdd adds value to sum using Neumaier compensated summation,
@@ -0,0 +1,223 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultParabolicSarStep is the default acceleration factor step.
+	DefaultParabolicSarStep = 0.02
+
+	// DefaultParabolicSarMaximum is the default maximum acceleration factor.
+	DefaultParabolicSarMaximum = 0.2
+
+	// ParabolicSarUp is the trend direction for an uptrend, where the SAR is below the prices.
+	ParabolicSarUp = 1
+
+	// ParabolicSarDown is the trend direction for a downtrend, where the SAR is above the prices.
+	ParabolicSarDown = -1
+)
+
+// ParabolicSar represents the configuration parameters for calculating the
+// Wilder's Parabolic Stop and Reverse (SAR). It trails the prices with a stop
+// that accelerates towards the extreme price of the trend, and reverses the
+// trend when the prices penetrate it.
+//
+//	SAR = Previous SAR + AF * (EP - Previous SAR)
+//
+// The Extreme Point (EP) is the highest high of an uptrend, or the lowest low
+// of a downtrend. The Acceleration Factor (AF) starts at the step, and grows
+// by the step each time a new extreme point is made, up to the maximum. The
+// SAR never moves into the range of the current or the previous period.
+//
+// Example:
+//
+//	psar := trend.NewParabolicSar[float64]()
+//	sars, trends := psar.ComputeWithContext(ctx, highs, lows)
+type ParabolicSar[T helper.Float] struct {
+	// Step is the acceleration factor step.
+	Step T
+
+	// Maximum is the maximum acceleration factor.
+	Maximum T
+}
+
+// NewParabolicSar function initializes a new Parabolic SAR instance with the default parameters.
+func NewParabolicSar[T helper.Float]() *ParabolicSar[T] {
+	return NewParabolicSarWithStepAndMaximum[T](DefaultParabolicSarStep, DefaultParabolicSarMaximum)
+}
+
+// NewParabolicSarWithStepAndMaximum function initializes a new Parabolic SAR
+// instance with the given acceleration factor step and maximum.
+func NewParabolicSarWithStepAndMaximum[T helper.Float](step, maximum T) *ParabolicSar[T] {
+	return &ParabolicSar[T]{
+		Step:    step,
+		Maximum: maximum,
+	}
+}
+
+// ParabolicSarState is the state of a Parabolic SAR that is advanced one
+// period at a time, such as for a trailing stop that starts at a given period.
+type ParabolicSarState[T helper.Float] struct {
+	psar         *ParabolicSar[T]
+	sar          T
+	ep           T
+	af           T
+	isLong       bool
+	previousHigh T
+	previousLow  T
+}
+
+// Start starts a new Parabolic SAR state in the given trend direction at the
+// period with the given high and low. The SAR starts at the low of an uptrend,
+// or at the high of a downtrend, and the extreme point at the other end.
+func (p *ParabolicSar[T]) Start(isLong bool, high, low T) *ParabolicSarState[T] {
+	s := &ParabolicSarState[T]{
+		psar:         p,
+		af:           p.Step,
+		isLong:       isLong,
+		previousHigh: high,
+		previousLow:  low,
+	}
+
+	if isLong {
+		s.sar, s.ep = low, high
+	} else {
+		s.sar, s.ep = high, low
+	}
+
+	return s
+}
+
+// Next takes the high and the low of the next period, and returns the SAR
+// along with the trend direction, which is ParabolicSarUp or
+// ParabolicSarDown, for that period. The trend reverses when the prices
+// penetrate the SAR.
+func (s *ParabolicSarState[T]) Next(high, low T) (T, T) {
+	var sar, trend T
+
+	if s.isLong {
+		if low <= s.sar {
+			// Reverse to a downtrend, starting from the extreme point.
+			s.isLong = false
+			s.sar = max(s.ep, s.previousHigh, high)
+			sar, trend = s.sar, ParabolicSarDown
+
+			s.af = s.psar.Step
+			s.ep = low
+			s.sar = max(s.sar+s.af*(s.ep-s.sar), s.previousHigh, high)
+		} else {
+			sar, trend = s.sar, ParabolicSarUp
+
+			if high > s.ep {
+				s.ep = high
+				s.af = min(s.af+s.psar.Step, s.psar.Maximum)
+			}
+
+			s.sar = min(s.sar+s.af*(s.ep-s.sar), s.previousLow, low)
+		}
+	} else {
+		if high >= s.sar {
+			// Reverse to an uptrend, starting from the extreme point.
+			s.isLong = true
+			s.sar = min(s.ep, s.previousLow, low)
+			sar, trend = s.sar, ParabolicSarUp
+
+			s.af = s.psar.Step
+			s.ep = high
+			s.sar = min(s.sar+s.af*(s.ep-s.sar), s.previousLow, low)
+		} else {
+			sar, trend = s.sar, ParabolicSarDown
+
+			if low < s.ep {
+				s.ep = low
+				s.af = min(s.af+s.psar.Step, s.psar.Maximum)
+			}
+
+			s.sar = max(s.sar+s.af*(s.ep-s.sar), s.previousHigh, high)
+		}
+	}
+
+	s.previousHigh, s.previousLow = high, low
+
+	return sar, trend
+}
+
+// parabolicSarResult is the SAR and the trend direction of a period.
+type parabolicSarResult[T helper.Float] struct {
+	Sar   T
+	Trend T
+}
+
+// ComputeWithContext function takes channels of highs and lows, and computes
+// the SAR along with the trend direction, which is ParabolicSarUp or
+// ParabolicSarDown, for each period.
+func (p *ParabolicSar[T]) ComputeWithContext(ctx context.Context, highs, lows <-chan T) (<-chan T, <-chan T) {
+	var state *ParabolicSarState[T]
+	var previousHigh, previousLow T
+	count := 0
+
+	results := helper.OperateWithContext(ctx, highs, lows, func(high, low T) parabolicSarResult[T] {
+		count++
+
+		switch count {
+		case 1:
+			// The first period only provides the previous values.
+			previousHigh, previousLow = high, low
+			return parabolicSarResult[T]{}
+
+		case 2:
+			// The initial trend is down only if the first move is a larger move down.
+			upMove := high - previousHigh
+			downMove := previousLow - low
+			state = p.Start(!(downMove > upMove && downMove > 0), previousHigh, previousLow)
+
+			// The extreme point starts at the current period.
+			if state.isLong {
+				state.ep = high
+			} else {
+				state.ep = low
+			}
+		}
+
+		sar, trend := state.Next(high, low)
+
+		return parabolicSarResult[T]{Sar: sar, Trend: trend}
+	})
+
+	resultsSplice := helper.DuplicateWithContext(ctx, helper.SkipWithContext(ctx, results, p.IdlePeriod()), 2)
+
+	sars := helper.MapWithContext(ctx, resultsSplice[0], func(r parabolicSarResult[T]) T {
+		return r.Sar
+	})
+
+	trends := helper.MapWithContext(ctx, resultsSplice[1], func(r parabolicSarResult[T]) T {
+		return r.Trend
+	})
+
+	return sars, trends
+}
+
+// IdlePeriod is the initial period that Parabolic SAR won't yield any results.
+func (*ParabolicSar[T]) IdlePeriod() int {
+	return 1
+}
+
+// String is the string representation of the Parabolic SAR.
+func (p *ParabolicSar[T]) String() string {
+	return fmt.Sprintf("PSAR(%v,%v)", p.Step, p.Maximum)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (p *ParabolicSar[T]) Compute(highs, lows <-chan T) (<-chan T, <-chan T) {
+	return p.ComputeWithContext(context.Background(), highs, lows)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestParabolicSar(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Sar   float64
+		Trend float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/parabolic_sar.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	psar := trend.NewParabolicSar[float64]()
+
+	inputs := helper.Duplicate(input, 4)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	expectedSars := helper.Skip(helper.Map(inputs[2], func(d *Data) float64 { return d.Sar }), psar.IdlePeriod())
+	expectedTrends := helper.Skip(helper.Map(inputs[3], func(d *Data) float64 { return d.Trend }), psar.IdlePeriod())
+
+	actualSars, actualTrends := psar.Compute(highs, lows)
+	actualSars = helper.RoundDigits(actualSars, 2)
+
+	err = helper.CheckEquals(actualSars, expectedSars, actualTrends, expectedTrends)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParabolicSarString(t *testing.T) {
+	expected := "PSAR(0.02,0.2)"
+	actual := trend.NewParabolicSar[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestParabolicSarState(t *testing.T) {
+	psar := trend.NewParabolicSar[float64]()
+	state := psar.Start(true, 10, 9)
+
+	sar, direction := state.Next(11, 9.5)
+	if sar != 9 || direction != trend.ParabolicSarUp {
+		t.Fatalf("actual %v %v expected 9 %v", sar, direction, trend.ParabolicSarUp)
+	}
+
+	sar, direction = state.Next(10.5, 8.9)
+	if sar != 11 || direction != trend.ParabolicSarDown {
+		t.Fatalf("actual %v %v expected 11 %v", sar, direction, trend.ParabolicSarDown)
+	}
+}
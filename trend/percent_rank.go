@@ -0,0 +1,70 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultPercentRankPeriod is the default period for the Percent Rank.
+	DefaultPercentRankPeriod = 20
+)
+
+// PercentRank represents the configuration parameters for calculating the
+// rolling Percent Rank, the percentage of the previous period-1 values that
+// are less than the current value. It is computed through the
+// helper.PercentRankWithContext function, which keeps the values in an order
+// statistics tree, so each update takes O(log n) time.
+//
+//	Percent Rank = Count(Previous Values < Value) / (Period - 1) * 100
+//
+// Example:
+//
+//	percentRank := trend.NewPercentRankWithPeriod[float64](20)
+//	result := percentRank.ComputeWithContext(ctx, c)
+type PercentRank[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewPercentRank function initializes a new Percent Rank instance with the default parameters.
+func NewPercentRank[T helper.Number]() *PercentRank[T] {
+	return NewPercentRankWithPeriod[T](DefaultPercentRankPeriod)
+}
+
+// NewPercentRankWithPeriod function initializes a new Percent Rank instance with the given period.
+// The period must be at least 2, as each value is ranked against the previous ones.
+func NewPercentRankWithPeriod[T helper.Number](period int) *PercentRank[T] {
+	return &PercentRank[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Percent Rank over the specified period, supporting context cancellation.
+func (p *PercentRank[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return helper.PercentRankWithContext(ctx, c, p.Period)
+}
+
+// IdlePeriod is the initial period that Percent Rank won't yield any results.
+func (p *PercentRank[T]) IdlePeriod() int {
+	return p.Period
+}
+
+// String is the string representation of the Percent Rank.
+func (p *PercentRank[T]) String() string {
+	return fmt.Sprintf("PERCENTRANK(%d)", p.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (p *PercentRank[T]) Compute(c <-chan T) <-chan T {
+	return p.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestPercentRank(t *testing.T) {
+	input := helper.SliceToChan([]float64{1, 2, 3, 2.5, 1, 5})
+	expected := helper.SliceToChan([]float64{50, 0, 100})
+
+	percentRank := trend.NewPercentRankWithPeriod[float64](3)
+
+	actual := helper.RoundDigits(percentRank.Compute(input), 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if percentRank.IdlePeriod() != 3 {
+		t.Fatalf("actual %v expected 3", percentRank.IdlePeriod())
+	}
+}
+
+func TestPercentRankString(t *testing.T) {
+	expected := "PERCENTRANK(20)"
+	actual := trend.NewPercentRank[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,1017 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// category is the category of the indicators in this package.
+const category = "trend"
+
+// closingsInputs is the inputs of the indicators computed over the closings.
+var closingsInputs = []string{helper.InputClosing}
+
+// regressionInputs is the inputs of the indicators computed over the x and y values.
+var regressionInputs = []string{"x", "y"}
+
+func init() {
+	registerMovingAverages()
+	registerOscillators()
+	registerPrices()
+}
+
+// registerMovingAverages registers the moving average indicators.
+func registerMovingAverages() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "sma",
+		Title:      "Simple Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"sma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewSmaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "ema",
+		Title:      "Exponential Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultEmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"ema"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewEmaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "dema",
+		Title:      "Double Exponential Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultEmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"dema"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			dema := NewDema[float64]()
+			dema.Ema1.Period = int(params["period"])
+			dema.Ema2.Period = int(params["period"])
+
+			return dema
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "tema",
+		Title:      "Triple Exponential Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultEmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"tema"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			tema := NewTema[float64]()
+			tema.Ema1.Period = int(params["period"])
+			tema.Ema2.Period = int(params["period"])
+			tema.Ema3.Period = int(params["period"])
+
+			return tema
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "trima",
+		Title:      "Triangular Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultTrimaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"trima"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			trima := NewTrima[float64]()
+			trima.Period = int(params["period"])
+
+			return trima
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "wma",
+		Title:      "Weighted Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultEmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"wma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewWmaWith[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "hma",
+		Title:      "Hull Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultEmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"hma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewHmaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "kama",
+		Title:    "Kaufman's Adaptive Moving Average",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("erPeriod", DefaultKamaErPeriod),
+			helper.NewPeriodParameter("fastScPeriod", DefaultKamaFastScPeriod),
+			helper.NewPeriodParameter("slowScPeriod", DefaultKamaSlowScPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"kama"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewKamaWith[float64](int(params["erPeriod"]), int(params["fastScPeriod"]), int(params["slowScPeriod"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "rma",
+		Title:      "Rolling Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultRmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"rma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewRmaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "smma",
+		Title:      "Smoothed Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSmmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"smma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewSmmaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "t3",
+		Title:    "Tillson T3 Moving Average",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultT3Period),
+			{
+				Name:        "volumeFactor",
+				Description: "Volume factor.",
+				Default:     DefaultT3VolumeFactor,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"t3"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewT3WithPeriodAndFactor[float64](int(params["period"]), params["volumeFactor"])
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "mcginley_dynamic",
+		Title:      "McGinley Dynamic",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultMcGinleyDynamicPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"mcginley_dynamic"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMcGinleyDynamicWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "alma",
+		Title:    "Arnaud Legoux Moving Average",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultAlmaPeriod),
+			{
+				Name:        "offset",
+				Description: "Position of the Gaussian center.",
+				Default:     DefaultAlmaOffset,
+				Min:         0,
+				Max:         1,
+			},
+			{
+				Name:        "sigma",
+				Description: "Sharpness of the Gaussian distribution.",
+				Default:     DefaultAlmaSigma,
+				Min:         0,
+				Max:         100,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"alma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewAlmaWith(int(params["period"]), params["offset"], params["sigma"])
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "zlema",
+		Title:      "Zero Lag Exponential Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultZlemaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"zlema"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewZlemaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "frama",
+		Title:      "Fractal Adaptive Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultFramaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"frama"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewFramaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "vidya",
+		Title:    "Variable Index Dynamic Average",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultVidyaPeriod),
+			helper.NewPeriodParameter("cmoPeriod", DefaultVidyaCmoPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"vidya"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewVidyaWithPeriods[float64](int(params["period"]), int(params["cmoPeriod"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "lsma",
+		Title:      "Least Squares Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameterWithMin("period", DefaultLsmaPeriod, 2)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"lsma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewLsmaWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "jma",
+		Title:    "Jurik-Style Moving Average",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultJmaPeriod),
+			{
+				Name:        "phase",
+				Description: "Trade-off between the lag and the overshoot.",
+				Default:     DefaultJmaPhase,
+				Min:         -100,
+				Max:         100,
+			},
+			{
+				Name:        "power",
+				Description: "Power of the alpha.",
+				Default:     DefaultJmaPower,
+				Min:         0,
+				Max:         10,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"jma"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewJmaWith(int(params["period"]), params["phase"], params["power"])
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "super_smoother",
+		Title:      "Ehlers Super Smoother",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSuperSmootherPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"super_smoother"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewSuperSmootherWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "mama",
+		Title:    "MESA Adaptive Moving Average",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "fastLimit",
+				Description: "Maximum alpha.",
+				Default:     DefaultMamaFastLimit,
+				Min:         0,
+				Max:         1,
+			},
+			{
+				Name:        "slowLimit",
+				Description: "Minimum alpha.",
+				Default:     DefaultMamaSlowLimit,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"mama", "fama"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mama := NewMamaWithLimits(params["fastLimit"], params["slowLimit"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				mamas, famas := mama.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{mamas, famas}
+			}, mama.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_max",
+		Title:      "Moving Max",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_max"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingMaxWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_min",
+		Title:      "Moving Min",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_min"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingMinWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_sum",
+		Title:      "Moving Sum",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSmaPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_sum"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingSumWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_median",
+		Title:      "Moving Median",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultMovingMedianPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_median"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingMedianWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "moving_quantile",
+		Title:    "Moving Quantile",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultMovingQuantilePeriod),
+			{
+				Name:        "quantile",
+				Description: "Quantile between 0 and 1.",
+				Default:     DefaultMovingQuantile,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"moving_quantile"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingQuantileWithPeriod[float64](int(params["period"]), params["quantile"])
+		}),
+	})
+}
+
+// registerOscillators registers the trend oscillator indicators.
+func registerOscillators() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "percent_rank",
+		Title:      "Percent Rank",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameterWithMin("period", DefaultPercentRankPeriod, 2)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"percent_rank"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewPercentRankWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "apo",
+		Title:    "Absolute Price Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("fastPeriod", DefaultApoFastPeriod),
+			helper.NewPeriodParameter("slowPeriod", DefaultApoSlowPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"apo"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			apo := NewApo[float64]()
+			apo.FastPeriod = int(params["fastPeriod"])
+			apo.SlowPeriod = int(params["slowPeriod"])
+
+			return apo
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "macd",
+		Title:    "Moving Average Convergence Divergence",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period1", DefaultMacdPeriod1),
+			helper.NewPeriodParameter("period2", DefaultMacdPeriod2),
+			helper.NewPeriodParameter("period3", DefaultMacdPeriod3),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"macd", "signal"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			macd := NewMacdWithPeriod[float64](int(params["period1"]), int(params["period2"]), int(params["period3"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				macds, signals := macd.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{macds, signals}
+			}, macd.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "aroon",
+		Title:      "Aroon Indicator",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultAroonPeriod)},
+		Inputs:     []string{helper.InputHigh, helper.InputLow},
+		Outputs:    []string{"up", "down"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			aroon := NewAroon[float64]()
+			aroon.Period = int(params["period"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ups, downs := aroon.ComputeWithContext(ctx, inputs[0], inputs[1])
+				return []<-chan float64{ups, downs}
+			}, aroon.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "adx",
+		Title:      "Average Directional Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultAdxPeriod)},
+		Inputs:     []string{helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs:    []string{"plus_di", "minus_di", "dx", "adx"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			adx := NewAdxWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				plusDi, minusDi, dx, adxs := adx.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{plusDi, minusDi, dx, adxs}
+			}, adx.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "parabolic_sar",
+		Title:    "Parabolic SAR",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "step",
+				Description: "Acceleration factor step.",
+				Default:     DefaultParabolicSarStep,
+				Min:         0,
+				Max:         1,
+			},
+			{
+				Name:        "maximum",
+				Description: "Maximum acceleration factor.",
+				Default:     DefaultParabolicSarMaximum,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  []string{helper.InputHigh, helper.InputLow},
+		Outputs: []string{"sar", "trend"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			psar := NewParabolicSarWithStepAndMaximum(params["step"], params["maximum"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				sars, trends := psar.ComputeWithContext(ctx, inputs[0], inputs[1])
+				return []<-chan float64{sars, trends}
+			}, psar.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "cci",
+		Title:      "Community Channel Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultCciPeriod)},
+		Inputs:     []string{helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs:    []string{"cci"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			cci := NewCciWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{cci.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, cci.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "cfo",
+		Title:      "Chande Forecast Oscillator",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultCfoPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"cfo"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewCfoWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "roc",
+		Title:      "Rate of Change",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultRocPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"roc"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewRocWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "slope",
+		Title:      "Slope",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultSlopePeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"slope"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewSlopeWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "trix",
+		Title:      "Triple Exponential Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultTrixPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"trix"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			trix := NewTrix[float64]()
+			trix.Period = int(params["period"])
+
+			return trix
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "tsi",
+		Title:    "True Strength Index",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("firstSmoothingPeriod", DefaultTsiFirstSmoothingPeriod),
+			helper.NewPeriodParameter("secondSmoothingPeriod", DefaultTsiSecondSmoothingPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"tsi"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewTsiWith[float64](int(params["firstSmoothingPeriod"]), int(params["secondSmoothingPeriod"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "stc",
+		Title:    "Schaff Trend Cycle",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("fastPeriod", DefaultStcFastPeriod),
+			helper.NewPeriodParameter("slowPeriod", DefaultStcSlowPeriod),
+			helper.NewPeriodParameter("kPeriod", DefaultStcKPeriod),
+			helper.NewPeriodParameter("dPeriod", DefaultStcDPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"stc"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewStcWithPeriod[float64](
+				int(params["fastPeriod"]),
+				int(params["slowPeriod"]),
+				int(params["kPeriod"]),
+				int(params["dPeriod"]),
+			)
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "roofing_filter",
+		Title:    "Ehlers Roofing Filter",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("highPassPeriod", DefaultRoofingFilterHighPassPeriod),
+			helper.NewPeriodParameter("superSmootherPeriod", DefaultSuperSmootherPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"roofing_filter"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewRoofingFilterWithPeriods[float64](int(params["highPassPeriod"]), int(params["superSmootherPeriod"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "hilbert_dominant_cycle",
+		Title:    "Hilbert Transform Dominant Cycle Period",
+		Category: category,
+		Inputs:   closingsInputs,
+		Outputs:  []string{"period"},
+		Builder: helper.NewIndicatorBuilder(func(map[string]float64) helper.Indicator[float64] {
+			return NewHilbertDominantCycle[float64]()
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "instantaneous_trendline",
+		Title:    "Ehlers Instantaneous Trendline",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "alpha",
+				Description: "Smoothing factor.",
+				Default:     DefaultInstantaneousTrendlineAlpha,
+				Min:         0,
+				Max:         1,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"trendline", "trigger"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			instantaneousTrendline := NewInstantaneousTrendlineWithAlpha(params["alpha"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				trendlines, triggers := instantaneousTrendline.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{trendlines, triggers}
+			}, instantaneousTrendline.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "dpo",
+		Title:      "Detrended Price Oscillator",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{dpoPeriodParameter()},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"dpo"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewDpoWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "kdj",
+		Title:    "Random Index (KDJ)",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultKdjMinMaxPeriod),
+			helper.NewPeriodParameter("sma1Period", DefaultKdjSma1Period),
+			helper.NewPeriodParameter("sma2Period", DefaultKdjSma2Period),
+		},
+		Inputs:  []string{helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs: []string{"k", "d", "j"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			kdj := NewKdj[float64]()
+			kdj.MovingMax.Period = int(params["period"])
+			kdj.MovingMin.Period = int(params["period"])
+			kdj.Sma1.Period = int(params["sma1Period"])
+			kdj.Sma2.Period = int(params["sma2Period"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ks, ds, js := kdj.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{ks, ds, js}
+			}, kdj.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "kst",
+		Title:    "Know Sure Thing",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("rocPeriod1", DefaultKstRocPeriod1),
+			helper.NewPeriodParameter("rocPeriod2", DefaultKstRocPeriod2),
+			helper.NewPeriodParameter("rocPeriod3", DefaultKstRocPeriod3),
+			helper.NewPeriodParameter("rocPeriod4", DefaultKstRocPeriod4),
+			helper.NewPeriodParameter("smaPeriod1", DefaultKstSmaPeriod1),
+			helper.NewPeriodParameter("smaPeriod2", DefaultKstSmaPeriod2),
+			helper.NewPeriodParameter("smaPeriod3", DefaultKstSmaPeriod3),
+			helper.NewPeriodParameter("smaPeriod4", DefaultKstSmaPeriod4),
+			helper.NewPeriodParameter("signalPeriod", DefaultKstSignalPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"kst", "signal"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			kst := NewKst[float64]()
+			kst.RocPeriod1 = int(params["rocPeriod1"])
+			kst.RocPeriod2 = int(params["rocPeriod2"])
+			kst.RocPeriod3 = int(params["rocPeriod3"])
+			kst.RocPeriod4 = int(params["rocPeriod4"])
+			kst.SmaPeriod1 = int(params["smaPeriod1"])
+			kst.SmaPeriod2 = int(params["smaPeriod2"])
+			kst.SmaPeriod3 = int(params["smaPeriod3"])
+			kst.SmaPeriod4 = int(params["smaPeriod4"])
+			kst.SignalPeriod = int(params["signalPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ksts, signals := kst.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{ksts, signals}
+			}, kst.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "mass_index",
+		Title:    "Mass Index",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("emaPeriod1", DefaultMassIndexPeriod1),
+			helper.NewPeriodParameter("emaPeriod2", DefaultMassIndexPeriod2),
+			helper.NewPeriodParameter("sumPeriod", DefaultMassIndexPeriod3),
+		},
+		Inputs:  []string{helper.InputHigh, helper.InputLow},
+		Outputs: []string{"mass_index"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mi := NewMassIndex[float64]()
+			mi.Ema1.Period = int(params["emaPeriod1"])
+			mi.Ema2.Period = int(params["emaPeriod2"])
+			mi.MovingSum.Period = int(params["sumPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{mi.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, mi.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "stochastic",
+		Title:    "Stochastic",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultStochasticPeriod),
+			helper.NewPeriodParameter("smaPeriod", DefaultStochasticSmaPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"k", "d"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			stochastic := NewStochasticWithPeriod[float64](int(params["period"]))
+			stochastic.Sma.Period = int(params["smaPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ks, ds := stochastic.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{ks, ds}
+			}, stochastic.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "slow_stochastic",
+		Title:    "Slow Stochastic",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultSlowStochasticPeriod),
+			helper.NewPeriodParameter("kPeriod", DefaultSlowStochasticKPeriod),
+			helper.NewPeriodParameter("dPeriod", DefaultSlowStochasticDPeriod),
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"k", "d"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			slowStochastic := NewSlowStochasticWithPeriod[float64](
+				int(params["period"]),
+				int(params["kPeriod"]),
+				int(params["dPeriod"]),
+			)
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ks, ds := slowStochastic.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{ks, ds}
+			}, slowStochastic.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "mls",
+		Title:      "Moving Least Square",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameterWithMin("period", DefaultLsmaPeriod, 2)},
+		Inputs:     regressionInputs,
+		Outputs:    []string{"m", "b"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mls := NewMlsWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				ms, bs := mls.ComputeWithContext(ctx, inputs[0], inputs[1])
+				return []<-chan float64{ms, bs}
+			}, mls.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "mlr",
+		Title:      "Moving Linear Regression",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameterWithMin("period", DefaultLsmaPeriod, 2)},
+		Inputs:     regressionInputs,
+		Outputs:    []string{"mlr"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mlr := NewMlrWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{mlr.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, mlr.IdlePeriod()
+		},
+	})
+}
+
+// registerPrices registers the price indicators.
+func registerPrices() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "bop",
+		Title:    "Balance of Power",
+		Category: category,
+		Inputs:   []string{helper.InputOpening, helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs:  []string{"bop"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			bop := NewBop[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{bop.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])}
+			}, bop.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "typical_price",
+		Title:    "Typical Price",
+		Category: category,
+		Inputs:   []string{helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs:  []string{"typical_price"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			typicalPrice := NewTypicalPrice[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{typicalPrice.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, typicalPrice.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "weighted_close",
+		Title:    "Weighted Close",
+		Category: category,
+		Inputs:   []string{helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs:  []string{"weighted_close"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			weightedClose := NewWeightedClose[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{weightedClose.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, weightedClose.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "vwma",
+		Title:      "Volume Weighted Moving Average",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultVwmaPeriod)},
+		Inputs:     []string{helper.InputClosing, helper.InputVolume},
+		Outputs:    []string{"vwma"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			vwma := NewVwma[float64]()
+			vwma.Period = int(params["period"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{vwma.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, vwma.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "envelope",
+		Title:    "Envelope",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultEnvelopePeriod),
+			{
+				Name:        "percentage",
+				Description: "Percentage of the bands from the SMA.",
+				Default:     DefaultEnvelopePercentage,
+				Min:         0,
+				Max:         100,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"upper", "middle", "lower"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			envelope := NewEnvelope[float64](NewSmaWithPeriod[float64](int(params["period"])), params["percentage"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				uppers, middles, lowers := envelope.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{uppers, middles, lowers}
+			}, envelope.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "pivot_point",
+		Title:    "Pivot Point",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "method",
+				Description: "Calculation method, 0 for Standard, 1 for Woodie, 2 for Camarilla, and 3 for Fibonacci.",
+				Default:     float64(PivotPointStandard),
+				Min:         float64(PivotPointStandard),
+				Max:         float64(PivotPointFibonacci),
+				Integer:     true,
+			},
+		},
+		Inputs:  []string{helper.InputOpening, helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs: []string{"p", "r1", "r2", "r3", "r4", "s1", "s2", "s3", "s4"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			pivotPoint := NewPivotPointWithMethod[float64](PivotPointMethod(params["method"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				fields := []func(PivotPointResult[float64]) float64{
+					func(r PivotPointResult[float64]) float64 { return r.P },
+					func(r PivotPointResult[float64]) float64 { return r.R1 },
+					func(r PivotPointResult[float64]) float64 { return r.R2 },
+					func(r PivotPointResult[float64]) float64 { return r.R3 },
+					func(r PivotPointResult[float64]) float64 { return r.R4 },
+					func(r PivotPointResult[float64]) float64 { return r.S1 },
+					func(r PivotPointResult[float64]) float64 { return r.S2 },
+					func(r PivotPointResult[float64]) float64 { return r.S3 },
+					func(r PivotPointResult[float64]) float64 { return r.S4 },
+				}
+
+				results := helper.DuplicateWithContext(ctx,
+					pivotPoint.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3]),
+					len(fields),
+				)
+
+				outputs := make([]<-chan float64, len(fields))
+				for i, field := range fields {
+					outputs[i] = helper.MapWithContext(ctx, results[i], field)
+				}
+
+				return outputs
+			}, pivotPoint.IdlePeriod()
+		},
+	})
+}
+
+// dpoPeriodParameter returns the period parameter of the DPO, which requires
+// at least two values.
+func dpoPeriodParameter() helper.IndicatorParameter {
+	parameter := helper.NewPeriodParameter("period", DefaultDpoPeriod)
+	parameter.Min = 2
+
+	return parameter
+}
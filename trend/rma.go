@@ -6,6 +6,7 @@ package trend
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -92,6 +93,11 @@ func (r *Rma[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
 	return result
 }
 
+// String is the string representation of the RMA.
+func (r *Rma[T]) String() string {
+	return fmt.Sprintf("RMA(%d)", r.Period)
+}
+
 // Compute wraps ComputeWithContext for backwards compatibility.
 //
 // Deprecated: Use ComputeWithContext instead.
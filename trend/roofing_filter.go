@@ -0,0 +1,99 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultRoofingFilterHighPassPeriod is the default high pass period of 48.
+	DefaultRoofingFilterHighPassPeriod = 48
+)
+
+// RoofingFilter represents the configuration parameters for calculating the
+// Ehlers' Roofing Filter. It passes only the cycles between the high pass
+// period and the Super Smoother period, by removing the trend with a two pole
+// high pass filter, and then removing the noise with the Super Smoother. The
+// result oscillates around zero.
+//
+//	a1 = (Cos(0.707 * 2 * Pi / HP Period) + Sin(0.707 * 2 * Pi / HP Period) - 1) / Cos(0.707 * 2 * Pi / HP Period)
+//	HP = (1 - a1 / 2)^2 * (Value - 2 * Previous Value + Second Previous Value) + 2 * (1 - a1) * Previous HP - (1 - a1)^2 * Second Previous HP
+//	Roofing Filter = Super Smoother(HP)
+//
+// The high pass filter starts with zero for the first two periods.
+//
+// Example:
+//
+//	roofingFilter := trend.NewRoofingFilter[float64]()
+//	result := roofingFilter.Compute(closings)
+type RoofingFilter[T helper.Float] struct {
+	// HighPassPeriod is the critical period of the high pass filter.
+	HighPassPeriod int
+
+	// SuperSmoother is the Super Smoother instance.
+	SuperSmoother *SuperSmoother[T]
+}
+
+// NewRoofingFilter function initializes a new Roofing Filter instance with the default parameters.
+func NewRoofingFilter[T helper.Float]() *RoofingFilter[T] {
+	return NewRoofingFilterWithPeriods[T](DefaultRoofingFilterHighPassPeriod, DefaultSuperSmootherPeriod)
+}
+
+// NewRoofingFilterWithPeriods function initializes a new Roofing Filter
+// instance with the given high pass and Super Smoother periods.
+func NewRoofingFilterWithPeriods[T helper.Float](highPassPeriod, superSmootherPeriod int) *RoofingFilter[T] {
+	return &RoofingFilter[T]{
+		HighPassPeriod: highPassPeriod,
+		SuperSmoother:  NewSuperSmootherWithPeriod[T](superSmootherPeriod),
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the Roofing Filter.
+func (r *RoofingFilter[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	angle := 0.707 * 2 * math.Pi / float64(r.HighPassPeriod)
+	a1 := T((math.Cos(angle) + math.Sin(angle) - 1) / math.Cos(angle))
+
+	count := 0
+	values := make([]T, 2)
+	highPasses := make([]T, 2)
+
+	highPassed := helper.MapWithContext(ctx, c, func(value T) T {
+		count++
+
+		var highPass T
+		if count > 2 {
+			highPass = (1-a1/2)*(1-a1/2)*(value-2*values[0]+values[1]) + 2*(1-a1)*highPasses[0] - (1-a1)*(1-a1)*highPasses[1]
+		}
+
+		pushEhlers(values, value)
+		pushEhlers(highPasses, highPass)
+
+		return highPass
+	})
+
+	return r.SuperSmoother.ComputeWithContext(ctx, highPassed)
+}
+
+// IdlePeriod is the initial period that Roofing Filter won't yield any results.
+func (*RoofingFilter[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Roofing Filter.
+func (r *RoofingFilter[T]) String() string {
+	return fmt.Sprintf("RF(%d,%d)", r.HighPassPeriod, r.SuperSmoother.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (r *RoofingFilter[T]) Compute(c <-chan T) <-chan T {
+	return r.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestRoofingFilter(t *testing.T) {
+	type Data struct {
+		Close         float64
+		RoofingFilter float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/roofing_filter.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.RoofingFilter })
+
+	roofingFilter := trend.NewRoofingFilter[float64]()
+	actual := roofingFilter.Compute(closing)
+	actual = helper.RoundDigits(actual, 2)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRoofingFilterString(t *testing.T) {
+	expected := "RF(48,10)"
+	actual := trend.NewRoofingFilter[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
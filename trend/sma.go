@@ -52,6 +52,16 @@ func (s *Sma[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
 	})
 }
 
+// ComputeChunksWithContext function takes a channel of chunks of numbers and
+// computes the SMA over the specified period, returning the chunks of the results.
+func (s *Sma[T]) ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T {
+	sum := NewMovingSumWithPeriod[T](s.Period)
+
+	return helper.MapChunksWithContext(ctx, sum.ComputeChunksWithContext(ctx, c), func(sum T) T {
+		return sum / T(s.Period)
+	})
+}
+
 // IdlePeriod is the initial period that SMA won't yield any results.
 func (s *Sma[T]) IdlePeriod() int {
 	return s.Period - 1
@@ -0,0 +1,107 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultSuperSmootherPeriod is the default Super Smoother period of 10.
+	DefaultSuperSmootherPeriod = 10
+)
+
+// SuperSmoother represents the configuration parameters for calculating the
+// Ehlers' Super Smoother filter. It is a two pole Butterworth low pass filter
+// that removes the high frequency noise with less lag than the moving averages
+// of the same period.
+//
+//	a1 = Exp(-1.414 * Pi / Period)
+//	c2 = 2 * a1 * Cos(1.414 * Pi / Period)
+//	c3 = -a1^2
+//	c1 = 1 - c2 - c3
+//	Filter = c1 * (Value + Previous Value) / 2 + c2 * Previous Filter + c3 * Second Previous Filter
+//
+// The filter starts with the values themselves for the first two periods.
+//
+// Example:
+//
+//	superSmoother := trend.NewSuperSmoother[float64]()
+//	result := superSmoother.Compute(closings)
+type SuperSmoother[T helper.Float] struct {
+	// Period is the critical period of the filter.
+	Period int
+}
+
+// NewSuperSmoother function initializes a new Super Smoother instance with the default parameters.
+func NewSuperSmoother[T helper.Float]() *SuperSmoother[T] {
+	return NewSuperSmootherWithPeriod[T](DefaultSuperSmootherPeriod)
+}
+
+// NewSuperSmootherWithPeriod function initializes a new Super Smoother instance with the given period.
+func NewSuperSmootherWithPeriod[T helper.Float](period int) *SuperSmoother[T] {
+	return &SuperSmoother[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the Super Smoother filter.
+func (s *SuperSmoother[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return helper.MapWithContext(ctx, c, s.filter())
+}
+
+// filter returns a function that filters the given values one at a time.
+func (s *SuperSmoother[T]) filter() func(T) T {
+	a1 := math.Exp(-math.Sqrt2 * math.Pi / float64(s.Period))
+	c2 := T(2 * a1 * math.Cos(math.Sqrt2*math.Pi/float64(s.Period)))
+	c3 := T(-a1 * a1)
+	c1 := 1 - c2 - c3
+
+	count := 0
+	var previousValue T
+	filters := make([]T, 2)
+
+	return func(value T) T {
+		count++
+
+		result := value
+		if count > 2 {
+			result = c1*(value+previousValue)/2 + c2*filters[0] + c3*filters[1]
+		}
+
+		previousValue = value
+		pushEhlers(filters, result)
+
+		return result
+	}
+}
+
+// IdlePeriod is the initial period that Super Smoother won't yield any results.
+func (*SuperSmoother[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Super Smoother.
+func (s *SuperSmoother[T]) String() string {
+	return fmt.Sprintf("SS(%d)", s.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (s *SuperSmoother[T]) Compute(c <-chan T) <-chan T {
+	return s.ComputeWithContext(context.Background(), c)
+}
+
+// pushEhlers shifts the given history of the values, where the first value is
+// the most recent one, and puts the given value as the most recent one.
+func pushEhlers[T any](history []T, value T) {
+	copy(history[1:], history)
+	history[0] = value
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// SwingHigh is the type of a swing high, a peak of the prices.
+	SwingHigh = 1
+
+	// SwingLow is the type of a swing low, a trough of the prices.
+	SwingLow = -1
+)
+
+// Swing is a swing high or a swing low pivot detected by the swing detectors,
+// such as the ZigZag and the Williams Fractal. A swing only becomes known some
+// periods after the pivot, once it is confirmed, so the ConfirmedDate should
+// be used instead of the Date when acting on the swings, to avoid the
+// lookahead bias.
+type Swing[T helper.Number] struct {
+	// Type is the swing type, either SwingHigh or SwingLow.
+	Type int
+
+	// Date is the date of the pivot.
+	Date time.Time
+
+	// Price is the high of a swing high, or the low of a swing low.
+	Price T
+
+	// ConfirmedDate is the date of the period that confirmed the pivot.
+	ConfirmedDate time.Time
+}
+
+// swingPeriod is the date, high, low, and closing of a period.
+type swingPeriod[T helper.Number] struct {
+	Date    time.Time
+	High    T
+	Low     T
+	Closing T
+}
+
+// detectSwingsWithContext feeds the periods to the given detector one at a
+// time, and emits the swings that each period confirms, supporting context
+// cancellation.
+func detectSwingsWithContext[T helper.Number](ctx context.Context, periods <-chan swingPeriod[T], detect func(swingPeriod[T]) []Swing[T]) <-chan Swing[T] {
+	result := make(chan Swing[T])
+
+	go func() {
+		defer close(result)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case period, ok := <-periods:
+				if !ok {
+					return
+				}
+
+				for _, swing := range detect(period) {
+					select {
+					case <-ctx.Done():
+						return
+					case result <- swing:
+					}
+				}
+			}
+		}
+	}()
+
+	return result
+}
@@ -6,6 +6,7 @@ package trend
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -64,6 +65,11 @@ func (t *Tema[T]) IdlePeriod() int {
 	return t.Ema1.Period + t.Ema2.Period + t.Ema3.Period - 3
 }
 
+// String is the string representation of the TEMA.
+func (t *Tema[T]) String() string {
+	return fmt.Sprintf("TEMA(%d)", t.Ema1.Period)
+}
+
 // Compute wraps ComputeWithContext for backwards compatibility.
 //
 // Deprecated: Use ComputeWithContext instead.
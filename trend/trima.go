@@ -6,6 +6,7 @@ package trend
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -76,6 +77,11 @@ func (t *Trima[T]) calculatePeriods() (int, int) {
 	return period1, period2
 }
 
+// String is the string representation of the TRIMA.
+func (t *Trima[T]) String() string {
+	return fmt.Sprintf("TRIMA(%d)", t.Period)
+}
+
 // Compute wraps ComputeWithContext for backwards compatibility.
 //
 // Deprecated: Use ComputeWithContext instead.
@@ -0,0 +1,118 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultVidyaPeriod is the default VIDYA period of 14.
+	DefaultVidyaPeriod = 14
+
+	// DefaultVidyaCmoPeriod is the default VIDYA Chande Momentum Oscillator period of 9.
+	DefaultVidyaCmoPeriod = 9
+)
+
+// Vidya represents the configuration parameters for calculating the Chande's
+// Variable Index Dynamic Average (VIDYA). It is an exponential moving average
+// whose alpha is scaled by the absolute value of the Chande Momentum
+// Oscillator (CMO), so that it moves faster in the trending markets.
+//
+//	CMO = (Sum of Gains - Sum of Losses) / (Sum of Gains + Sum of Losses)
+//	Alpha = 2 / (Period + 1)
+//	VIDYA = Alpha * |CMO| * Value + (1 - Alpha * |CMO|) * Previous VIDYA
+//
+// The VIDYA starts with the value at the end of the first CMO period.
+//
+// Example:
+//
+//	vidya := trend.NewVidya[float64]()
+//	result := vidya.Compute(closings)
+type Vidya[T helper.Float] struct {
+	// Period is the time period of the exponential moving average.
+	Period int
+
+	// CmoPeriod is the time period of the Chande Momentum Oscillator.
+	CmoPeriod int
+}
+
+// NewVidya function initializes a new VIDYA instance with the default parameters.
+func NewVidya[T helper.Float]() *Vidya[T] {
+	return NewVidyaWithPeriods[T](DefaultVidyaPeriod, DefaultVidyaCmoPeriod)
+}
+
+// NewVidyaWithPeriods function initializes a new VIDYA instance with the given periods.
+func NewVidyaWithPeriods[T helper.Float](period, cmoPeriod int) *Vidya[T] {
+	return &Vidya[T]{
+		Period:    period,
+		CmoPeriod: cmoPeriod,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the VIDYA.
+func (v *Vidya[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	alpha := 2 / T(v.Period+1)
+	window := helper.NewRing[T](v.CmoPeriod + 1)
+
+	first := true
+	var vidya T
+
+	vidyas := helper.MapWithContext(ctx, c, func(value T) T {
+		window.Put(value)
+
+		if !window.IsFull() {
+			return 0
+		}
+
+		if first {
+			first = false
+			vidya = value
+			return vidya
+		}
+
+		var gains, losses T
+		for i := 1; i <= v.CmoPeriod; i++ {
+			change := window.At(i) - window.At(i-1)
+			if change > 0 {
+				gains += change
+			} else {
+				losses -= change
+			}
+		}
+
+		var cmo T
+		if gains+losses > 0 {
+			cmo = (gains - losses) / (gains + losses)
+		}
+
+		k := alpha * max(cmo, -cmo)
+		vidya = k*value + (1-k)*vidya
+
+		return vidya
+	})
+
+	return helper.SkipWithContext(ctx, vidyas, v.IdlePeriod())
+}
+
+// IdlePeriod is the initial period that VIDYA won't yield any results.
+func (v *Vidya[T]) IdlePeriod() int {
+	return v.CmoPeriod
+}
+
+// String is the string representation of the VIDYA.
+func (v *Vidya[T]) String() string {
+	return fmt.Sprintf("VIDYA(%d,%d)", v.Period, v.CmoPeriod)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (v *Vidya[T]) Compute(c <-chan T) <-chan T {
+	return v.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultZigZagPercentage is the default minimum reversal percentage of 5.
+	DefaultZigZagPercentage = 5
+
+	// DefaultZigZagAtrPeriod is the default ATR period of 14.
+	DefaultZigZagAtrPeriod = 14
+
+	// DefaultZigZagAtrMultiplier is the default ATR multiplier of 3.
+	DefaultZigZagAtrMultiplier = 3
+)
+
+// ZigZag represents the configuration parameters for detecting the swing
+// highs and the swing lows with the ZigZag. It follows the extreme price of
+// the current leg, and confirms it as a swing once the prices reverse from it
+// by at least the threshold. The threshold is either a percentage of the
+// extreme price, or a multiple of the Average True Range (ATR), which is the
+// simple moving average of the true ranges, when the ATR period is set.
+//
+//	Swing High = Highest High of the up leg, once Low <= Highest High - Threshold
+//	Swing Low = Lowest Low of the down leg, once High >= Lowest Low + Threshold
+//
+// The last leg repaints. Its extreme keeps moving as long as the prices make
+// new extremes in its direction, and it may never be confirmed, which is why
+// the ZigZag line of the charting tools changes its last segment. Only the
+// confirmed swings are emitted, with the date of the confirming period as the
+// ConfirmedDate, so that the emitted swings never change. The tentative
+// extreme of the last leg is not emitted.
+//
+// Example:
+//
+//	zigZag := trend.NewZigZag[float64]()
+//	swings := zigZag.ComputeWithContext(ctx, dates, highs, lows, closings)
+type ZigZag[T helper.Float] struct {
+	// Percentage is the minimum reversal as a percentage of the extreme price.
+	Percentage T
+
+	// AtrPeriod is the ATR period. The ATR is used instead of the percentage
+	// when it is greater than zero.
+	AtrPeriod int
+
+	// AtrMultiplier is the minimum reversal as a multiple of the ATR.
+	AtrMultiplier T
+}
+
+// NewZigZag function initializes a new ZigZag instance with the default percentage.
+func NewZigZag[T helper.Float]() *ZigZag[T] {
+	return NewZigZagWithPercentage[T](DefaultZigZagPercentage)
+}
+
+// NewZigZagWithPercentage function initializes a new ZigZag instance with the
+// given minimum reversal percentage.
+func NewZigZagWithPercentage[T helper.Float](percentage T) *ZigZag[T] {
+	return &ZigZag[T]{
+		Percentage: percentage,
+	}
+}
+
+// NewZigZagWithAtr function initializes a new ZigZag instance with the given
+// ATR period and multiplier as the minimum reversal.
+func NewZigZagWithAtr[T helper.Float](period int, multiplier T) *ZigZag[T] {
+	return &ZigZag[T]{
+		AtrPeriod:     period,
+		AtrMultiplier: multiplier,
+	}
+}
+
+// ComputeWithContext function takes channels of dates, highs, lows, and
+// closings, and returns a channel of the confirmed swings in the order that
+// they are confirmed, which alternate between the swing highs and the swing
+// lows. The closings are only used by the ATR.
+func (z *ZigZag[T]) ComputeWithContext(ctx context.Context, dates <-chan time.Time, highs, lows, closings <-chan T) <-chan Swing[T] {
+	periods := helper.Operate4WithContext(ctx, dates, highs, lows, closings, func(date time.Time, high, low, closing T) swingPeriod[T] {
+		return swingPeriod[T]{
+			Date:    date,
+			High:    high,
+			Low:     low,
+			Closing: closing,
+		}
+	})
+
+	var averageTrueRange *helper.AverageTrueRange[T]
+	if z.AtrPeriod > 0 {
+		averageTrueRange = helper.NewAverageTrueRange[T](z.AtrPeriod)
+	}
+
+	count := 0
+
+	// Extreme prices of the current leg. Until the first swing, both the
+	// highest high and the lowest low are followed.
+	var highest, lowest Swing[T]
+	direction := 0
+
+	return detectSwingsWithContext(ctx, periods, func(period swingPeriod[T]) []Swing[T] {
+		count++
+
+		atr, hasAtr := T(0), false
+		if averageTrueRange != nil {
+			atr, hasAtr = averageTrueRange.Add(period.High, period.Low, period.Closing)
+		}
+
+		// threshold returns the minimum reversal from the given extreme price.
+		threshold := func(extreme T) (T, bool) {
+			if averageTrueRange != nil {
+				return z.AtrMultiplier * atr, hasAtr
+			}
+
+			return extreme * z.Percentage / 100, true
+		}
+
+		if count == 1 {
+			highest = Swing[T]{Type: SwingHigh, Date: period.Date, Price: period.High}
+			lowest = Swing[T]{Type: SwingLow, Date: period.Date, Price: period.Low}
+			return nil
+		}
+
+		// The up leg and the undecided start follow the highest high.
+		if direction >= 0 {
+			if period.High > highest.Price {
+				highest.Date, highest.Price = period.Date, period.High
+			} else if reversal, ok := threshold(highest.Price); ok && period.Low <= highest.Price-reversal {
+				swing := highest
+				swing.ConfirmedDate = period.Date
+
+				direction = -1
+				lowest = Swing[T]{Type: SwingLow, Date: period.Date, Price: period.Low}
+
+				return []Swing[T]{swing}
+			}
+		}
+
+		// The down leg and the undecided start follow the lowest low.
+		if direction <= 0 {
+			if period.Low < lowest.Price {
+				lowest.Date, lowest.Price = period.Date, period.Low
+			} else if reversal, ok := threshold(lowest.Price); ok && period.High >= lowest.Price+reversal {
+				swing := lowest
+				swing.ConfirmedDate = period.Date
+
+				direction = 1
+				highest = Swing[T]{Type: SwingHigh, Date: period.Date, Price: period.High}
+
+				return []Swing[T]{swing}
+			}
+		}
+
+		return nil
+	})
+}
+
+// String is the string representation of the ZigZag.
+func (z *ZigZag[T]) String() string {
+	if z.AtrPeriod > 0 {
+		return fmt.Sprintf("ZigZag(ATR(%d)*%v)", z.AtrPeriod, z.AtrMultiplier)
+	}
+
+	return fmt.Sprintf("ZigZag(%v%%)", z.Percentage)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (z *ZigZag[T]) Compute(dates <-chan time.Time, highs, lows, closings <-chan T) <-chan Swing[T] {
+	return z.ComputeWithContext(context.Background(), dates, highs, lows, closings)
+}
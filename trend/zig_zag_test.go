@@ -0,0 +1,91 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// swingDay returns the date of the given day of the test series.
+func swingDay(day int) time.Time {
+	return time.Date(2024, time.January, day, 0, 0, 0, 0, time.UTC)
+}
+
+// swingDays returns the dates of the given number of days of the test series.
+func swingDays(count int) <-chan time.Time {
+	dates := make([]time.Time, count)
+	for i := range dates {
+		dates[i] = swingDay(i + 1)
+	}
+
+	return helper.SliceToChan(dates)
+}
+
+func TestZigZag(t *testing.T) {
+	prices := []float64{100, 105, 110, 104, 98, 97, 103, 108, 106}
+
+	expected := []trend.Swing[float64]{
+		{Type: trend.SwingLow, Date: swingDay(1), Price: 100, ConfirmedDate: swingDay(3)},
+		{Type: trend.SwingHigh, Date: swingDay(3), Price: 110, ConfirmedDate: swingDay(5)},
+		{Type: trend.SwingLow, Date: swingDay(6), Price: 97, ConfirmedDate: swingDay(8)},
+	}
+
+	zigZag := trend.NewZigZagWithPercentage[float64](10)
+
+	actual := helper.ChanToSlice(zigZag.Compute(
+		swingDays(len(prices)),
+		helper.SliceToChan(prices),
+		helper.SliceToChan(prices),
+		helper.SliceToChan(prices),
+	))
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestZigZagWithAtr(t *testing.T) {
+	prices := []float64{100, 105, 110, 104, 98, 97, 103, 108, 106}
+
+	expected := []trend.Swing[float64]{
+		{Type: trend.SwingLow, Date: swingDay(1), Price: 100, ConfirmedDate: swingDay(3)},
+		{Type: trend.SwingHigh, Date: swingDay(3), Price: 110, ConfirmedDate: swingDay(4)},
+		{Type: trend.SwingLow, Date: swingDay(6), Price: 97, ConfirmedDate: swingDay(7)},
+	}
+
+	zigZag := trend.NewZigZagWithAtr[float64](2, 1)
+
+	actual := helper.ChanToSlice(zigZag.Compute(
+		swingDays(len(prices)),
+		helper.SliceToChan(prices),
+		helper.SliceToChan(prices),
+		helper.SliceToChan(prices),
+	))
+
+	if !slices.Equal(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestZigZagString(t *testing.T) {
+	expected := "ZigZag(5%)"
+	actual := trend.NewZigZag[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	expected = "ZigZag(ATR(14)*3)"
+	actual = trend.NewZigZagWithAtr[float64](trend.DefaultZigZagAtrPeriod, trend.DefaultZigZagAtrMultiplier).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultZlemaPeriod is the default ZLEMA period of 20.
+	DefaultZlemaPeriod = 20
+)
+
+// Zlema represents the configuration parameters for calculating the Zero Lag
+// Exponential Moving Average (ZLEMA). It removes the lag of the EMA by adding
+// the momentum over the lag period to the values before the EMA.
+//
+//	Lag = (Period - 1) / 2
+//	ZLEMA = EMA(Period, 2 * Value - Value[Lag periods ago])
+//
+// Example:
+//
+//	zlema := trend.NewZlema[float64]()
+//	result := zlema.Compute(closings)
+type Zlema[T helper.Float] struct {
+	// Period is the time period.
+	Period int
+}
+
+// NewZlema function initializes a new ZLEMA instance with the default parameters.
+func NewZlema[T helper.Float]() *Zlema[T] {
+	return NewZlemaWithPeriod[T](DefaultZlemaPeriod)
+}
+
+// NewZlemaWithPeriod function initializes a new ZLEMA instance with the given period.
+func NewZlemaWithPeriod[T helper.Float](period int) *Zlema[T] {
+	return &Zlema[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the ZLEMA.
+func (z *Zlema[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	lag := z.lag()
+	window := helper.NewRing[T](lag + 1)
+
+	adjusted := helper.MapWithContext(ctx, c, func(value T) T {
+		window.Put(value)
+
+		if !window.IsFull() {
+			return 0
+		}
+
+		return 2*value - window.At(0)
+	})
+
+	adjusted = helper.SkipWithContext(ctx, adjusted, lag)
+
+	return NewEmaWithPeriod[T](z.Period).ComputeWithContext(ctx, adjusted)
+}
+
+// IdlePeriod is the initial period that ZLEMA won't yield any results.
+func (z *Zlema[T]) IdlePeriod() int {
+	return z.lag() + z.Period - 1
+}
+
+// String is the string representation of the ZLEMA.
+func (z *Zlema[T]) String() string {
+	return fmt.Sprintf("ZLEMA(%d)", z.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (z *Zlema[T]) Compute(c <-chan T) <-chan T {
+	return z.ComputeWithContext(context.Background(), c)
+}
+
+// lag returns the lag period that is removed.
+func (z *Zlema[T]) lag() int {
+	return (z.Period - 1) / 2
+}
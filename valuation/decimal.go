@@ -0,0 +1,37 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package valuation
+
+import "github.com/cinar/indicator/v2/helper"
+
+// DecimalFv calculates the Future Value (FV) of a Present Value (PV) using fixed-point decimals.
+//
+//	Formula: FV = PV * (1 + rate)^years
+func DecimalFv(pv, rate helper.Decimal, years int) helper.Decimal {
+	return pv.Mul(helper.NewDecimalFromInt(1).Add(rate).Pow(years))
+}
+
+// DecimalPv calculates the Present Value (PV) of a Future Value (FV) using fixed-point decimals.
+//
+//	Formula: PV = FV / (1 + rate)^years
+func DecimalPv(fv, rate helper.Decimal, years int) helper.Decimal {
+	return fv.Div(helper.NewDecimalFromInt(1).Add(rate).Pow(years))
+}
+
+// DecimalNpv calculates the Net Present Value (NPV) of a series of cash flows using fixed-point decimals.
+//
+//	Formula: NPV = sum(CF_i / (1 + rate)^i) for i = 1 to n
+func DecimalNpv(rate helper.Decimal, cfs []helper.Decimal) helper.Decimal {
+	growth := helper.NewDecimalFromInt(1).Add(rate)
+	discount := helper.NewDecimalFromInt(1)
+
+	var npv helper.Decimal
+	for _, cf := range cfs {
+		discount = discount.Mul(growth)
+		npv = npv.Add(cf.Div(discount))
+	}
+
+	return npv
+}
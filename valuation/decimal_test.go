@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package valuation_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/valuation"
+)
+
+func TestDecimalFv(t *testing.T) {
+	expected := helper.MustParseDecimal("1102.5")
+	actual := valuation.DecimalFv(helper.MustParseDecimal("1000"), helper.MustParseDecimal("0.05"), 2)
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestDecimalPv(t *testing.T) {
+	expected := helper.MustParseDecimal("1000")
+	actual := valuation.DecimalPv(helper.MustParseDecimal("1102.5"), helper.MustParseDecimal("0.05"), 2)
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestDecimalNpv(t *testing.T) {
+	cfs := []helper.Decimal{
+		helper.MustParseDecimal("110"),
+		helper.MustParseDecimal("121"),
+	}
+
+	expected := helper.MustParseDecimal("200")
+	actual := valuation.DecimalNpv(helper.MustParseDecimal("0.1"), cfs)
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingAutocorrelationPeriod is the default period for the Moving Autocorrelation.
+	DefaultMovingAutocorrelationPeriod = 20
+
+	// DefaultMovingAutocorrelationLag is the default lag for the Moving Autocorrelation.
+	DefaultMovingAutocorrelationLag = 1
+)
+
+// MovingAutocorrelation represents the configuration parameters for calculating
+// the Moving Autocorrelation over the specified period. It is the correlation
+// of the values in the period with the same values shifted by the lag, using
+// the standard sample autocorrelation estimator. It is zero when the values do
+// not vary within the period, and the lag must be less than the period.
+//
+//	ACF = Sum((value[i] - Mean) * (value[i-lag] - Mean)) / Sum((value[i] - Mean)^2)
+//
+// Example:
+//
+//	acf := volatility.NewMovingAutocorrelationWithPeriod[float64](20, 1)
+//	result := acf.ComputeWithContext(ctx, c)
+type MovingAutocorrelation[T helper.Number] struct {
+	// Time period.
+	Period int
+
+	// Lag in number of values.
+	Lag int
+}
+
+// NewMovingAutocorrelation function initializes a new Moving Autocorrelation
+// instance with the default parameters.
+func NewMovingAutocorrelation[T helper.Number]() *MovingAutocorrelation[T] {
+	return NewMovingAutocorrelationWithPeriod[T](DefaultMovingAutocorrelationPeriod, DefaultMovingAutocorrelationLag)
+}
+
+// NewMovingAutocorrelationWithPeriod function initializes a new Moving
+// Autocorrelation instance with the given period and lag.
+func NewMovingAutocorrelationWithPeriod[T helper.Number](period, lag int) *MovingAutocorrelation[T] {
+	return &MovingAutocorrelation[T]{
+		Period: period,
+		Lag:    lag,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Moving Autocorrelation over the specified period, supporting context cancellation.
+func (m *MovingAutocorrelation[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return movingWindowWithContext(ctx, c, m.Period, func(values []float64) float64 {
+		average := mean(values)
+
+		variance := centralMoment(values, average, 2)
+		if variance == 0 || m.Lag >= len(values) {
+			return 0
+		}
+
+		sum := 0.0
+		for i := m.Lag; i < len(values); i++ {
+			sum += (values[i] - average) * (values[i-m.Lag] - average)
+		}
+
+		return sum / float64(len(values)) / variance
+	})
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingAutocorrelation[T]) Compute(c <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), c)
+}
+
+// IdlePeriod is the initial period that Moving Autocorrelation won't yield any results.
+func (m *MovingAutocorrelation[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Autocorrelation.
+func (m *MovingAutocorrelation[T]) String() string {
+	return fmt.Sprintf("ACF(%d,%d)", m.Period, m.Lag)
+}
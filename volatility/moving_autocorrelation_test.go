@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingAutocorrelation(t *testing.T) {
+	input := helper.SliceToChan([]float64{1, 2, 3, 4, 4, 4, 4, 4})
+	expected := helper.SliceToChan([]float64{0.25, 0.25, -0.08, 0, 0})
+
+	acf := volatility.NewMovingAutocorrelationWithPeriod[float64](4, 1)
+
+	actual := acf.Compute(input)
+	actual = helper.RoundDigits(actual, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if acf.IdlePeriod() != 3 {
+		t.Fatalf("actual %v expected 3", acf.IdlePeriod())
+	}
+}
+
+func TestMovingAutocorrelationString(t *testing.T) {
+	expected := "ACF(20,1)"
+	actual := volatility.NewMovingAutocorrelation[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingBetaPeriod is the default period for the Moving Beta.
+	DefaultMovingBetaPeriod = 20
+)
+
+// MovingBeta represents the configuration parameters for calculating the
+// Moving Beta of an asset against a benchmark over the specified period. It
+// measures the sensitivity of the asset to the benchmark, and it is usually
+// computed over the returns, such as the ones from helper.ChangeRatio. It is
+// zero when the benchmark does not vary within the period.
+//
+//	Beta = Cov(asset, benchmark) / Var(benchmark)
+//
+// Example:
+//
+//	beta := volatility.NewMovingBetaWithPeriod[float64](60)
+//	result := beta.ComputeWithContext(ctx, assetReturns, benchmarkReturns)
+type MovingBeta[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingBeta function initializes a new Moving Beta instance with the default parameters.
+func NewMovingBeta[T helper.Number]() *MovingBeta[T] {
+	return NewMovingBetaWithPeriod[T](DefaultMovingBetaPeriod)
+}
+
+// NewMovingBetaWithPeriod function initializes a new Moving Beta instance with the given period.
+func NewMovingBetaWithPeriod[T helper.Number](period int) *MovingBeta[T] {
+	return &MovingBeta[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes the channels of the asset and the benchmark
+// values, and computes the Moving Beta over the specified period, supporting
+// context cancellation.
+func (m *MovingBeta[T]) ComputeWithContext(ctx context.Context, asset, benchmark <-chan T) <-chan T {
+	return movingPairsWindowWithContext(ctx, asset, benchmark, m.Period, func(xs, ys []float64) float64 {
+		variance := centralMoment(ys, mean(ys), 2)
+		if variance == 0 {
+			return 0
+		}
+
+		return covariance(xs, ys) / variance
+	})
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingBeta[T]) Compute(asset, benchmark <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), asset, benchmark)
+}
+
+// IdlePeriod is the initial period that Moving Beta won't yield any results.
+func (m *MovingBeta[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Beta.
+func (m *MovingBeta[T]) String() string {
+	return fmt.Sprintf("BETA(%d)", m.Period)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingBeta(t *testing.T) {
+	asset := helper.SliceToChan([]float64{0.02, -0.04, 0.06, 0.01, 0.03})
+	benchmark := helper.SliceToChan([]float64{0.01, -0.02, 0.03, 0.03, 0.03})
+	expected := helper.SliceToChan([]float64{2, 1.5, 0})
+
+	beta := volatility.NewMovingBetaWithPeriod[float64](3)
+
+	actual := beta.Compute(asset, benchmark)
+	actual = helper.RoundDigits(actual, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if beta.IdlePeriod() != 2 {
+		t.Fatalf("actual %v expected 2", beta.IdlePeriod())
+	}
+}
+
+func TestMovingBetaString(t *testing.T) {
+	expected := "BETA(20)"
+	actual := volatility.NewMovingBeta[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingCorrelationPeriod is the default period for the Moving Correlation.
+	DefaultMovingCorrelationPeriod = 20
+)
+
+// MovingCorrelation represents the configuration parameters for calculating the
+// Moving Pearson Correlation Coefficient of two series over the specified
+// period. The result is between -1 and 1, and it is zero when either of the
+// series does not vary within the period.
+//
+//	Corr = Cov(x, y) / (Std(x) * Std(y))
+//
+// Example:
+//
+//	correlation := volatility.NewMovingCorrelationWithPeriod[float64](20)
+//	result := correlation.ComputeWithContext(ctx, x, y)
+type MovingCorrelation[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingCorrelation function initializes a new Moving Correlation instance with the default parameters.
+func NewMovingCorrelation[T helper.Number]() *MovingCorrelation[T] {
+	return NewMovingCorrelationWithPeriod[T](DefaultMovingCorrelationPeriod)
+}
+
+// NewMovingCorrelationWithPeriod function initializes a new Moving Correlation instance with the given period.
+func NewMovingCorrelationWithPeriod[T helper.Number](period int) *MovingCorrelation[T] {
+	return &MovingCorrelation[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes two channels of numbers and computes the
+// Moving Correlation over the specified period, supporting context cancellation.
+func (m *MovingCorrelation[T]) ComputeWithContext(ctx context.Context, x, y <-chan T) <-chan T {
+	return movingPairsWindowWithContext(ctx, x, y, m.Period, correlation)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingCorrelation[T]) Compute(x, y <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), x, y)
+}
+
+// IdlePeriod is the initial period that Moving Correlation won't yield any results.
+func (m *MovingCorrelation[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Correlation.
+func (m *MovingCorrelation[T]) String() string {
+	return fmt.Sprintf("CORR(%d)", m.Period)
+}
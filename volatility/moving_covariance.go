@@ -0,0 +1,67 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingCovariancePeriod is the default period for the Moving Covariance.
+	DefaultMovingCovariancePeriod = 20
+)
+
+// MovingCovariance represents the configuration parameters for calculating the
+// Moving Covariance of two series over the specified period. It is the
+// population covariance, consistent with the Moving Standard Deviation.
+//
+//	Cov = 1/Period * Sum((x - Mean(x)) * (y - Mean(y)))
+//
+// Example:
+//
+//	covariance := volatility.NewMovingCovarianceWithPeriod[float64](20)
+//	result := covariance.ComputeWithContext(ctx, x, y)
+type MovingCovariance[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingCovariance function initializes a new Moving Covariance instance with the default parameters.
+func NewMovingCovariance[T helper.Number]() *MovingCovariance[T] {
+	return NewMovingCovarianceWithPeriod[T](DefaultMovingCovariancePeriod)
+}
+
+// NewMovingCovarianceWithPeriod function initializes a new Moving Covariance instance with the given period.
+func NewMovingCovarianceWithPeriod[T helper.Number](period int) *MovingCovariance[T] {
+	return &MovingCovariance[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes two channels of numbers and computes the
+// Moving Covariance over the specified period, supporting context cancellation.
+func (m *MovingCovariance[T]) ComputeWithContext(ctx context.Context, x, y <-chan T) <-chan T {
+	return movingPairsWindowWithContext(ctx, x, y, m.Period, covariance)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingCovariance[T]) Compute(x, y <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), x, y)
+}
+
+// IdlePeriod is the initial period that Moving Covariance won't yield any results.
+func (m *MovingCovariance[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Covariance.
+func (m *MovingCovariance[T]) String() string {
+	return fmt.Sprintf("COV(%d)", m.Period)
+}
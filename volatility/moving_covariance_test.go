@@ -0,0 +1,41 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingCovariance(t *testing.T) {
+	x := helper.SliceToChan([]float64{1, 2, 3, 4})
+	y := helper.SliceToChan([]float64{2, 4, 6, 9})
+	expected := helper.SliceToChan([]float64{1.33, 1.67})
+
+	covariance := volatility.NewMovingCovarianceWithPeriod[float64](3)
+
+	actual := covariance.Compute(x, y)
+	actual = helper.RoundDigits(actual, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if covariance.IdlePeriod() != 2 {
+		t.Fatalf("actual %v expected 2", covariance.IdlePeriod())
+	}
+}
+
+func TestMovingCovarianceString(t *testing.T) {
+	expected := "COV(20)"
+	actual := volatility.NewMovingCovariance[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingKurtosisPeriod is the default period for the Moving Kurtosis.
+	DefaultMovingKurtosisPeriod = 20
+)
+
+// MovingKurtosis represents the configuration parameters for calculating the
+// Moving Kurtosis over the specified period. It is the population excess
+// kurtosis, measuring how heavy the tails of the values are compared to the
+// normal distribution, which has zero excess kurtosis. It is zero when the
+// values do not vary within the period.
+//
+//	Kurtosis = M4 / M2^2 - 3
+//	Mk = 1/Period * Sum((value - Mean)^k)
+//
+// Example:
+//
+//	kurtosis := volatility.NewMovingKurtosisWithPeriod[float64](20)
+//	result := kurtosis.ComputeWithContext(ctx, c)
+type MovingKurtosis[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingKurtosis function initializes a new Moving Kurtosis instance with the default parameters.
+func NewMovingKurtosis[T helper.Number]() *MovingKurtosis[T] {
+	return NewMovingKurtosisWithPeriod[T](DefaultMovingKurtosisPeriod)
+}
+
+// NewMovingKurtosisWithPeriod function initializes a new Moving Kurtosis instance with the given period.
+func NewMovingKurtosisWithPeriod[T helper.Number](period int) *MovingKurtosis[T] {
+	return &MovingKurtosis[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Moving Kurtosis over the specified period, supporting context cancellation.
+func (m *MovingKurtosis[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return movingWindowWithContext(ctx, c, m.Period, func(values []float64) float64 {
+		average := mean(values)
+
+		m2 := centralMoment(values, average, 2)
+		if m2 == 0 {
+			return 0
+		}
+
+		return centralMoment(values, average, 4)/(m2*m2) - 3
+	})
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingKurtosis[T]) Compute(c <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), c)
+}
+
+// IdlePeriod is the initial period that Moving Kurtosis won't yield any results.
+func (m *MovingKurtosis[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Kurtosis.
+func (m *MovingKurtosis[T]) String() string {
+	return fmt.Sprintf("KURT(%d)", m.Period)
+}
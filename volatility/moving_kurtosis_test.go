@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingKurtosis(t *testing.T) {
+	input := helper.SliceToChan([]float64{1, 2, 3, 10, 10, 10, 10})
+	expected := helper.SliceToChan([]float64{-0.77, -1.96, -0.67, 0})
+
+	kurtosis := volatility.NewMovingKurtosisWithPeriod[float64](4)
+
+	actual := kurtosis.Compute(input)
+	actual = helper.RoundDigits(actual, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if kurtosis.IdlePeriod() != 3 {
+		t.Fatalf("actual %v expected 3", kurtosis.IdlePeriod())
+	}
+}
+
+func TestMovingKurtosisString(t *testing.T) {
+	expected := "KURT(20)"
+	actual := volatility.NewMovingKurtosis[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
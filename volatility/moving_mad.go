@@ -0,0 +1,74 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingMadPeriod is the default period for the Moving Median Absolute Deviation.
+	DefaultMovingMadPeriod = 20
+)
+
+// MovingMad represents the configuration parameters for calculating the Moving
+// Median Absolute Deviation (MAD) over the specified period. It is a robust
+// measure of the dispersion that is much less affected by the outliers than
+// the standard deviation. The result is not scaled; multiply it by 1.4826 to
+// estimate the standard deviation of normally distributed values.
+//
+//	MAD = Median(Abs(value - Median(values)))
+//
+// The values of the period are kept in an order statistics tree, so each
+// update takes O(log² n) time.
+//
+// Example:
+//
+//	mad := volatility.NewMovingMadWithPeriod[float64](20)
+//	result := mad.ComputeWithContext(ctx, c)
+type MovingMad[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingMad function initializes a new Moving MAD instance with the default parameters.
+func NewMovingMad[T helper.Number]() *MovingMad[T] {
+	return NewMovingMadWithPeriod[T](DefaultMovingMadPeriod)
+}
+
+// NewMovingMadWithPeriod function initializes a new Moving MAD instance with the given period.
+func NewMovingMadWithPeriod[T helper.Number](period int) *MovingMad[T] {
+	return &MovingMad[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Moving MAD over the specified period, supporting context cancellation.
+func (m *MovingMad[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return helper.MovingOrderStatisticsWithContext(ctx, c, m.Period, func(tree *helper.OrderStatisticsTree[T], _ T) T {
+		return tree.MedianAbsoluteDeviation()
+	})
+}
+
+// IdlePeriod is the initial period that Moving MAD won't yield any results.
+func (m *MovingMad[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving MAD.
+func (m *MovingMad[T]) String() string {
+	return fmt.Sprintf("MAD(%d)", m.Period)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingMad[T]) Compute(c <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), c)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingMad(t *testing.T) {
+	input := helper.SliceToChan([]float64{1, 2, 3, 4, 100, 6})
+	expected := helper.SliceToChan([]float64{1, 2})
+
+	mad := volatility.NewMovingMadWithPeriod[float64](5)
+
+	actual := mad.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mad.IdlePeriod() != 4 {
+		t.Fatalf("actual %v expected 4", mad.IdlePeriod())
+	}
+}
+
+func TestMovingMadString(t *testing.T) {
+	expected := "MAD(20)"
+	actual := volatility.NewMovingMad[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
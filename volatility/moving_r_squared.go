@@ -0,0 +1,71 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingRSquaredPeriod is the default period for the Moving R-Squared.
+	DefaultMovingRSquaredPeriod = 20
+)
+
+// MovingRSquared represents the configuration parameters for calculating the
+// Moving Coefficient of Determination (R²) of two series over the specified
+// period. It is the share of the variance of one series that is explained by
+// a linear regression on the other one, between 0 and 1.
+//
+//	R² = Corr(x, y)²
+//
+// Example:
+//
+//	rSquared := volatility.NewMovingRSquaredWithPeriod[float64](20)
+//	result := rSquared.ComputeWithContext(ctx, x, y)
+type MovingRSquared[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingRSquared function initializes a new Moving R-Squared instance with the default parameters.
+func NewMovingRSquared[T helper.Number]() *MovingRSquared[T] {
+	return NewMovingRSquaredWithPeriod[T](DefaultMovingRSquaredPeriod)
+}
+
+// NewMovingRSquaredWithPeriod function initializes a new Moving R-Squared instance with the given period.
+func NewMovingRSquaredWithPeriod[T helper.Number](period int) *MovingRSquared[T] {
+	return &MovingRSquared[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes two channels of numbers and computes the
+// Moving R-Squared over the specified period, supporting context cancellation.
+func (m *MovingRSquared[T]) ComputeWithContext(ctx context.Context, x, y <-chan T) <-chan T {
+	return movingPairsWindowWithContext(ctx, x, y, m.Period, func(xs, ys []float64) float64 {
+		r := correlation(xs, ys)
+		return r * r
+	})
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingRSquared[T]) Compute(x, y <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), x, y)
+}
+
+// IdlePeriod is the initial period that Moving R-Squared won't yield any results.
+func (m *MovingRSquared[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving R-Squared.
+func (m *MovingRSquared[T]) String() string {
+	return fmt.Sprintf("RSQUARED(%d)", m.Period)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingRSquared(t *testing.T) {
+	x := helper.SliceToChan([]float64{1, 2, 3, 4, 5})
+	y := helper.SliceToChan([]float64{3, 2, 1, 1, 1})
+	expected := helper.SliceToChan([]float64{1, 0.75, 0})
+
+	rSquared := volatility.NewMovingRSquaredWithPeriod[float64](3)
+
+	actual := rSquared.Compute(x, y)
+	actual = helper.RoundDigits(actual, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rSquared.IdlePeriod() != 2 {
+		t.Fatalf("actual %v expected 2", rSquared.IdlePeriod())
+	}
+}
+
+func TestMovingRSquaredString(t *testing.T) {
+	expected := "RSQUARED(20)"
+	actual := volatility.NewMovingRSquared[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMovingSkewnessPeriod is the default period for the Moving Skewness.
+	DefaultMovingSkewnessPeriod = 20
+)
+
+// MovingSkewness represents the configuration parameters for calculating the
+// Moving Skewness over the specified period. It is the population skewness,
+// measuring the asymmetry of the values around their mean. It is zero when
+// the values do not vary within the period.
+//
+//	Skewness = M3 / M2^1.5
+//	Mk = 1/Period * Sum((value - Mean)^k)
+//
+// Example:
+//
+//	skewness := volatility.NewMovingSkewnessWithPeriod[float64](20)
+//	result := skewness.ComputeWithContext(ctx, c)
+type MovingSkewness[T helper.Number] struct {
+	// Time period.
+	Period int
+}
+
+// NewMovingSkewness function initializes a new Moving Skewness instance with the default parameters.
+func NewMovingSkewness[T helper.Number]() *MovingSkewness[T] {
+	return NewMovingSkewnessWithPeriod[T](DefaultMovingSkewnessPeriod)
+}
+
+// NewMovingSkewnessWithPeriod function initializes a new Moving Skewness instance with the given period.
+func NewMovingSkewnessWithPeriod[T helper.Number](period int) *MovingSkewness[T] {
+	return &MovingSkewness[T]{
+		Period: period,
+	}
+}
+
+// ComputeWithContext function takes a channel of numbers and computes the
+// Moving Skewness over the specified period, supporting context cancellation.
+func (m *MovingSkewness[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
+	return movingWindowWithContext(ctx, c, m.Period, func(values []float64) float64 {
+		average := mean(values)
+
+		m2 := centralMoment(values, average, 2)
+		if m2 == 0 {
+			return 0
+		}
+
+		return centralMoment(values, average, 3) / math.Pow(m2, 1.5)
+	})
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (m *MovingSkewness[T]) Compute(c <-chan T) <-chan T {
+	return m.ComputeWithContext(context.Background(), c)
+}
+
+// IdlePeriod is the initial period that Moving Skewness won't yield any results.
+func (m *MovingSkewness[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the Moving Skewness.
+func (m *MovingSkewness[T]) String() string {
+	return fmt.Sprintf("SKEW(%d)", m.Period)
+}
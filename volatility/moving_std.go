@@ -42,12 +42,10 @@ func NewMovingStdWithPeriod[T helper.Number](period int) *MovingStd[T] {
 func (m *MovingStd[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-chan T {
 	result := make(chan T, cap(c))
 
-	//	Std = Sqrt(1/Period * Sum(Pow(value - sma), 2))
 	go func() {
 		defer close(result)
 
-		ring := helper.NewRing[T](m.Period)
-		sum := T(0)
+		step := m.step()
 
 		for {
 			select {
@@ -57,23 +55,16 @@ func (m *MovingStd[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-cha
 				if !ok {
 					return
 				}
-				sum -= ring.Put(n)
-				sum += n
-
-				if ring.IsFull() {
-					sma := sum / T(m.Period)
-					sum2 := T(0)
-
-					for i := 0; i < m.Period; i++ {
-						sum2 += T(math.Pow(float64(ring.At(i)-sma), 2))
-					}
-
-					std := T(math.Sqrt(float64(sum2 / T(m.Period))))
-					select {
-					case <-ctx.Done():
-						return
-					case result <- std:
-					}
+
+				std, ok := step(n)
+				if !ok {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case result <- std:
 				}
 			}
 		}
@@ -82,6 +73,37 @@ func (m *MovingStd[T]) ComputeWithContext(ctx context.Context, c <-chan T) <-cha
 	return result
 }
 
+// ComputeChunksWithContext function takes a channel of chunks of numbers and computes the Moving Standard Deviation over the specified period, returning the chunks of the results.
+func (m *MovingStd[T]) ComputeChunksWithContext(ctx context.Context, c <-chan []T) <-chan []T {
+	return helper.FilterMapChunksWithContext(ctx, c, m.step())
+}
+
+// step returns a function that adds the given value to the moving window, and
+// returns the standard deviation once the window is full.
+func (m *MovingStd[T]) step() func(T) (T, bool) {
+	//	Std = Sqrt(1/Period * Sum(Pow(value - sma), 2))
+	ring := helper.NewRing[T](m.Period)
+	sum := T(0)
+
+	return func(n T) (T, bool) {
+		sum -= ring.Put(n)
+		sum += n
+
+		if !ring.IsFull() {
+			return 0, false
+		}
+
+		sma := sum / T(m.Period)
+		sum2 := T(0)
+
+		for i := 0; i < m.Period; i++ {
+			sum2 += T(math.Pow(float64(ring.At(i)-sma), 2))
+		}
+
+		return T(math.Sqrt(float64(sum2 / T(m.Period)))), true
+	}
+}
+
 // Compute wraps ComputeWithContext for backwards compatibility.
 //
 // Deprecated: Use ComputeWithContext instead.
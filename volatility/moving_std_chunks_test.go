@@ -0,0 +1,28 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestMovingStdChunks(t *testing.T) {
+	ctx := context.Background()
+
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9, 3, 1, 8}
+	std := volatility.NewMovingStdWithPeriod[float64](4)
+
+	expected := std.ComputeWithContext(ctx, helper.SliceToChan(values))
+	actual := helper.UnchunkWithContext(ctx, std.ComputeChunksWithContext(ctx, helper.SliceToChunks(values, 3)))
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// movingWindowWithContext calls the given function with the last period values
+// of the given channel, ordered from the oldest to the newest, once the window
+// is full, supporting context cancellation.
+func movingWindowWithContext[T helper.Number](ctx context.Context, c <-chan T, period int, f func([]float64) float64) <-chan T {
+	pairs := helper.MapWithContext(ctx, c, func(n T) [2]T {
+		return [2]T{n, 0}
+	})
+
+	return movingPairWindowWithContext(ctx, pairs, period, func(xs, _ []float64) float64 {
+		return f(xs)
+	})
+}
+
+// movingPairsWindowWithContext calls the given function with the last period
+// values of the given channels, ordered from the oldest to the newest, once
+// the window is full, supporting context cancellation.
+func movingPairsWindowWithContext[T helper.Number](ctx context.Context, x, y <-chan T, period int, f func(xs, ys []float64) float64) <-chan T {
+	pairs := helper.OperateWithContext(ctx, x, y, func(a, b T) [2]T {
+		return [2]T{a, b}
+	})
+
+	return movingPairWindowWithContext(ctx, pairs, period, f)
+}
+
+// movingPairWindowWithContext calls the given function with the last period
+// pairs of values, ordered from the oldest to the newest, once the window is
+// full, supporting context cancellation.
+func movingPairWindowWithContext[T helper.Number](ctx context.Context, c <-chan [2]T, period int, f func(xs, ys []float64) float64) <-chan T {
+	result := make(chan T)
+
+	go func() {
+		defer close(result)
+
+		// Values are kept twice, so that a window is always a contiguous slice.
+		xs := make([]float64, 2*period)
+		ys := make([]float64, 2*period)
+		count := 0
+
+		for {
+			var pair [2]T
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case pair, ok = <-c:
+			}
+
+			if !ok {
+				return
+			}
+
+			index := count % period
+			xs[index], xs[index+period] = float64(pair[0]), float64(pair[0])
+			ys[index], ys[index+period] = float64(pair[1]), float64(pair[1])
+			count++
+
+			if count < period {
+				continue
+			}
+
+			start := count % period
+
+			select {
+			case <-ctx.Done():
+				return
+			case result <- T(f(xs[start:start+period], ys[start:start+period])):
+			}
+		}
+	}()
+
+	return result
+}
+
+// mean returns the mean of the given values.
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+// centralMoment returns the k-th population central moment of the given values.
+func centralMoment(values []float64, average float64, k int) float64 {
+	sum := 0.0
+	for _, value := range values {
+		d := value - average
+
+		product := 1.0
+		for i := 0; i < k; i++ {
+			product *= d
+		}
+
+		sum += product
+	}
+
+	return sum / float64(len(values))
+}
+
+// covariance returns the population covariance of the given values.
+func covariance(xs, ys []float64) float64 {
+	xMean := mean(xs)
+	yMean := mean(ys)
+
+	sum := 0.0
+	for i := range xs {
+		sum += (xs[i] - xMean) * (ys[i] - yMean)
+	}
+
+	return sum / float64(len(xs))
+}
+
+// correlation returns the Pearson correlation coefficient of the given values,
+// or zero if either of them does not vary.
+func correlation(xs, ys []float64) float64 {
+	xMean := mean(xs)
+	yMean := mean(ys)
+
+	xy, xx, yy := 0.0, 0.0, 0.0
+	for i := range xs {
+		dx := xs[i] - xMean
+		dy := ys[i] - yMean
+
+		xy += dx * dy
+		xx += dx * dx
+		yy += dy * dy
+	}
+
+	if xx == 0 || yy == 0 {
+		return 0
+	}
+
+	return xy / math.Sqrt(xx*yy)
+}
@@ -0,0 +1,382 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"context"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// category is the category of the indicators in this package.
+const category = "volatility"
+
+// closingsInputs is the inputs of the indicators computed over the closings.
+var closingsInputs = []string{helper.InputClosing}
+
+// barInputs is the inputs of the indicators computed over the highs, lows, and closings.
+var barInputs = []string{helper.InputHigh, helper.InputLow, helper.InputClosing}
+
+// bandOutputs is the outputs of the band indicators.
+var bandOutputs = []string{"upper", "middle", "lower"}
+
+func init() {
+	registerBands()
+	registerRanges()
+	registerDeviations()
+}
+
+// registerBands registers the band and channel indicators.
+func registerBands() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "bollinger_bands",
+		Title:    "Bollinger Bands",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultBollingerBandsPeriod),
+			{
+				Name:        "multiplier",
+				Description: "Standard deviation multiplier.",
+				Default:     DefaultBollingerBandsMultiplier,
+				Min:         0,
+				Max:         10,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: bandOutputs,
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			bb := NewBollingerBandsWithPeriod[float64](int(params["period"]))
+			bb.Multiplier = params["multiplier"]
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				upper, middle, lower := bb.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{upper, middle, lower}
+			}, bb.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "bollinger_band_width",
+		Title:      "Bollinger Band Width",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultBollingerBandsPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"bollinger_band_width"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			bbw := NewBollingerBandWidth[float64]()
+			bbw.BollingerBands.Period = int(params["period"])
+
+			return bbw
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "percent_b",
+		Title:      "Percent B",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultBollingerBandsPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"percent_b"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewPercentBWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "donchian_channel",
+		Title:      "Donchian Channel",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultDonchianChannelPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    bandOutputs,
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			dc := NewDonchianChannelWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				upper, middle, lower := dc.ComputeWithContext(ctx, inputs[0])
+				return []<-chan float64{upper, middle, lower}
+			}, dc.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "keltner_channel",
+		Title:      "Keltner Channel",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultKeltnerChannelPeriod)},
+		Inputs:     barInputs,
+		Outputs:    bandOutputs,
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			kc := NewKeltnerChannelWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				upper, middle, lower := kc.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{upper, middle, lower}
+			}, kc.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "acceleration_bands",
+		Title:      "Acceleration Bands",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultAccelerationBandsPeriod)},
+		Inputs:     barInputs,
+		Outputs:    bandOutputs,
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ab := NewAccelerationBands[float64]()
+			ab.Period = int(params["period"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				upper, middle, lower := ab.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{upper, middle, lower}
+			}, ab.IdlePeriod()
+		},
+	})
+}
+
+// registerRanges registers the range based indicators.
+func registerRanges() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "atr",
+		Title:      "Average True Range",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultAtrPeriod)},
+		Inputs:     barInputs,
+		Outputs:    []string{"atr"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			atr := NewAtrWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{atr.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, atr.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "true_range",
+		Title:    "True Range",
+		Category: category,
+		Inputs:   barInputs,
+		Outputs:  []string{"true_range"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			tr := NewTrueRange[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{tr.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, tr.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "chop",
+		Title:      "Choppiness Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultChopPeriod)},
+		Inputs:     barInputs,
+		Outputs:    []string{"chop"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			chop := NewChopWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{chop.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, chop.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "po",
+		Title:      "Projection Oscillator",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultPoPeriod)},
+		Inputs:     barInputs,
+		Outputs:    []string{"po"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			po := NewPoWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{po.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, po.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "super_trend",
+		Title:    "Super Trend",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultSuperTrendPeriod),
+			{
+				Name:        "multiplier",
+				Description: "ATR multiplier.",
+				Default:     DefaultSuperTrendMultiplier,
+				Min:         0,
+				Max:         10,
+			},
+		},
+		Inputs:  barInputs,
+		Outputs: []string{"super_trend"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			superTrend := NewSuperTrendWithPeriod(int(params["period"]), params["multiplier"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{superTrend.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, superTrend.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "chandelier_exit",
+		Title:    "Chandelier Exit",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultChandelierExitPeriod),
+			{
+				Name:        "multiplier",
+				Description: "ATR multiplier.",
+				Default:     DefaultChandelierExitMultiplier,
+				Min:         0,
+				Max:         10,
+			},
+		},
+		Inputs:  barInputs,
+		Outputs: []string{"long", "short"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ce := NewChandelierExit[float64]()
+			ce.Period = int(params["period"])
+			ce.Multiplier = params["multiplier"]
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				long, short := ce.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{long, short}
+			}, ce.IdlePeriod()
+		},
+	})
+}
+
+// registerDeviations registers the deviation based indicators.
+func registerDeviations() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_std",
+		Title:      "Moving Standard Deviation",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultBollingerBandsPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_std"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingStdWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_mad",
+		Title:      "Moving Median Absolute Deviation",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultMovingMadPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_mad"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingMadWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_skewness",
+		Title:      "Moving Skewness",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultMovingSkewnessPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_skewness"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingSkewnessWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "moving_kurtosis",
+		Title:      "Moving Kurtosis",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultMovingKurtosisPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"moving_kurtosis"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingKurtosisWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "moving_autocorrelation",
+		Title:    "Moving Autocorrelation",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("period", DefaultMovingAutocorrelationPeriod),
+			{
+				Name:        "lag",
+				Description: "Lag in number of values.",
+				Default:     DefaultMovingAutocorrelationLag,
+				Min:         1,
+				Max:         math.MaxInt32,
+				Integer:     true,
+			},
+		},
+		Inputs:  closingsInputs,
+		Outputs: []string{"moving_autocorrelation"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewMovingAutocorrelationWithPeriod[float64](int(params["period"]), int(params["lag"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "z_score",
+		Title:      "Z-Score",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultZScorePeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"z_score"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewZScoreWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "ulcer_index",
+		Title:      "Ulcer Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultUlcerIndexPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"ulcer_index"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			ui := NewUlcerIndex[float64]()
+			ui.Period = int(params["period"])
+
+			return ui
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "historical_volatility",
+		Title:      "Historical Volatility",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultHistoricalVolatilityPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"historical_volatility"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewHistoricalVolatilityWithPeriod[float64](int(params["period"]))
+		}),
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "annualized_historical_volatility",
+		Title:      "Annualized Historical Volatility",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultAnnualizedHistoricalVolatilityPeriod)},
+		Inputs:     closingsInputs,
+		Outputs:    []string{"annualized_historical_volatility"},
+		Builder: helper.NewIndicatorBuilder(func(params map[string]float64) helper.Indicator[float64] {
+			return NewAnnualizedHistoricalVolatilityWithPeriod[float64](int(params["period"]))
+		}),
+	})
+}
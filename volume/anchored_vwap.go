@@ -0,0 +1,174 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultAnchoredVwapBandMultiplier is the default standard deviation multiplier of the bands.
+	DefaultAnchoredVwapBandMultiplier = 2
+)
+
+// AnchoredVwap represents the configuration parameters for calculating the
+// Volume Weighted Average Price (VWAP) that accumulates from an anchor, along
+// with the standard deviation bands around it. Unlike the rolling period Vwap,
+// it restarts at the start of each time bucket, such as each trading session
+// for the session VWAP, or each anchor date, such as the earnings dates or the
+// swing lows, for the anchored VWAP. The typical price is used by default.
+//
+//	VWAP = Sum(Price * Volume) / Sum(Volume)
+//	Std = Sqrt(Sum(Price^2 * Volume) / Sum(Volume) - VWAP^2)
+//	Upper Band = VWAP + Multiplier * Std
+//	Lower Band = VWAP - Multiplier * Std
+//
+// The sums start over at each bucket, and the values before the first anchor
+// accumulate from the start of the series.
+//
+// Example:
+//
+//	vwap := volume.NewSessionVwap[float64]()
+//	vwaps, uppers, lowers := vwap.ComputeWithContext(ctx, dates, highs, lows, closings, volumes)
+type AnchoredVwap[T helper.Float] struct {
+	// Bucket is the time bucket that the VWAP restarts at.
+	Bucket helper.TimeBucket
+
+	// Multiplier is the standard deviation multiplier of the bands.
+	Multiplier T
+}
+
+// NewSessionVwap function initializes a new VWAP instance that restarts at
+// each day with the default band multiplier.
+func NewSessionVwap[T helper.Float]() *AnchoredVwap[T] {
+	return NewAnchoredVwapWithBucket[T](helper.DayBucket)
+}
+
+// NewAnchoredVwap function initializes a new VWAP instance that restarts at
+// each of the given anchor dates with the default band multiplier.
+func NewAnchoredVwap[T helper.Float](anchors ...time.Time) *AnchoredVwap[T] {
+	return NewAnchoredVwapWithBucket[T](helper.NewAnchorBucket(anchors...))
+}
+
+// NewAnchoredVwapWithBucket function initializes a new VWAP instance that
+// restarts at each of the given time buckets, such as the sessions of the
+// helper.NewSessionBucket, with the default band multiplier.
+func NewAnchoredVwapWithBucket[T helper.Float](bucket helper.TimeBucket) *AnchoredVwap[T] {
+	return &AnchoredVwap[T]{
+		Bucket:     bucket,
+		Multiplier: DefaultAnchoredVwapBandMultiplier,
+	}
+}
+
+// anchoredVwapTrade is the price and the volume of a period.
+type anchoredVwapTrade[T helper.Float] struct {
+	Price  T
+	Volume T
+}
+
+// anchoredVwapSums is the running sums of the volumes, the prices times the
+// volumes, and the squared prices times the volumes since the anchor, along
+// with the last price.
+type anchoredVwapSums[T helper.Float] struct {
+	Volume   T
+	Weighted T
+	Squared  T
+	Price    T
+}
+
+// anchoredVwapResult is the VWAP and the standard deviation of a period.
+type anchoredVwapResult[T helper.Float] struct {
+	Vwap T
+	Std  T
+}
+
+// ComputeWithContext function takes channels of dates, highs, lows, closings,
+// and volumes, and computes the VWAP of the typical prices along with its
+// upper and lower bands.
+func (a *AnchoredVwap[T]) ComputeWithContext(ctx context.Context, dates <-chan time.Time, highs, lows, closings, volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
+	prices := trend.NewTypicalPrice[T]().ComputeWithContext(ctx, highs, lows, closings)
+	return a.ComputePricesWithContext(ctx, dates, prices, volumes)
+}
+
+// ComputePricesWithContext function takes channels of dates, prices, and
+// volumes, and computes the VWAP of the given prices, such as the closings,
+// along with its upper and lower bands.
+func (a *AnchoredVwap[T]) ComputePricesWithContext(ctx context.Context, dates <-chan time.Time, prices, volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
+	trades := helper.Operate3WithContext(ctx, dates, prices, volumes, func(date time.Time, price, volume T) helper.Dated[anchoredVwapTrade[T]] {
+		return helper.NewDated(date, anchoredVwapTrade[T]{
+			Price:  price,
+			Volume: volume,
+		})
+	})
+
+	sums := helper.AnchoredAccumulateWithContext(ctx, trades, a.Bucket, func(sums anchoredVwapSums[T], trade anchoredVwapTrade[T]) anchoredVwapSums[T] {
+		return anchoredVwapSums[T]{
+			Volume:   sums.Volume + trade.Volume,
+			Weighted: sums.Weighted + trade.Price*trade.Volume,
+			Squared:  sums.Squared + trade.Price*trade.Price*trade.Volume,
+			Price:    trade.Price,
+		}
+	})
+
+	results := helper.MapWithContext(ctx, sums, func(s helper.Dated[anchoredVwapSums[T]]) anchoredVwapResult[T] {
+		return anchoredVwap(s.Value)
+	})
+	resultsSplice := helper.DuplicateWithContext(ctx, results, 3)
+
+	vwaps := helper.MapWithContext(ctx, resultsSplice[0], func(r anchoredVwapResult[T]) T {
+		return r.Vwap
+	})
+
+	uppers := helper.MapWithContext(ctx, resultsSplice[1], func(r anchoredVwapResult[T]) T {
+		return r.Vwap + a.Multiplier*r.Std
+	})
+
+	lowers := helper.MapWithContext(ctx, resultsSplice[2], func(r anchoredVwapResult[T]) T {
+		return r.Vwap - a.Multiplier*r.Std
+	})
+
+	return vwaps, uppers, lowers
+}
+
+// anchoredVwap returns the VWAP and the volume weighted standard deviation
+// from the given running sums. Without any volume, the VWAP is the last price.
+func anchoredVwap[T helper.Float](sums anchoredVwapSums[T]) anchoredVwapResult[T] {
+	if sums.Volume == 0 {
+		return anchoredVwapResult[T]{
+			Vwap: sums.Price,
+		}
+	}
+
+	vwap := sums.Weighted / sums.Volume
+	variance := max(sums.Squared/sums.Volume-vwap*vwap, 0)
+
+	return anchoredVwapResult[T]{
+		Vwap: vwap,
+		Std:  T(math.Sqrt(float64(variance))),
+	}
+}
+
+// IdlePeriod is the initial period that the anchored VWAP won't yield any results.
+func (*AnchoredVwap[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the anchored VWAP.
+func (a *AnchoredVwap[T]) String() string {
+	return fmt.Sprintf("AVWAP(%v)", a.Multiplier)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (a *AnchoredVwap[T]) Compute(dates <-chan time.Time, highs, lows, closings, volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
+	return a.ComputeWithContext(context.Background(), dates, highs, lows, closings, volumes)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// anchoredVwapDates is the dates of two sessions.
+var anchoredVwapDates = []time.Time{
+	time.Date(2024, time.January, 2, 9, 30, 0, 0, time.UTC),
+	time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC),
+	time.Date(2024, time.January, 2, 10, 30, 0, 0, time.UTC),
+	time.Date(2024, time.January, 3, 9, 30, 0, 0, time.UTC),
+	time.Date(2024, time.January, 3, 10, 0, 0, 0, time.UTC),
+}
+
+func TestSessionVwap(t *testing.T) {
+	prices := helper.SliceToChan([]float64{10, 12, 11, 20, 22})
+	volumes := helper.SliceToChan([]float64{1, 1, 2, 1, 3})
+
+	expectedVwaps := helper.SliceToChan([]float64{10, 11, 11, 20, 21.5})
+	expectedUppers := helper.SliceToChan([]float64{10, 13, 12.4142, 20, 23.2321})
+	expectedLowers := helper.SliceToChan([]float64{10, 9, 9.5858, 20, 19.7679})
+
+	vwap := volume.NewSessionVwap[float64]()
+
+	vwaps, uppers, lowers := vwap.ComputePricesWithContext(context.Background(), helper.SliceToChan(anchoredVwapDates), prices, volumes)
+	uppers = helper.RoundDigits(uppers, 4)
+	lowers = helper.RoundDigits(lowers, 4)
+
+	err := helper.CheckEquals(vwaps, expectedVwaps, uppers, expectedUppers, lowers, expectedLowers)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwap(t *testing.T) {
+	prices := helper.Duplicate(helper.SliceToChan([]float64{10, 12, 11, 20, 22}), 3)
+	volumes := helper.SliceToChan([]float64{1, 1, 2, 1, 3})
+
+	expected := helper.SliceToChan([]float64{10, 11, 11, 20, 21.5})
+
+	vwap := volume.NewAnchoredVwap[float64](anchoredVwapDates[3])
+
+	vwaps, uppers, lowers := vwap.Compute(helper.SliceToChan(anchoredVwapDates), prices[0], prices[1], prices[2], volumes)
+	go helper.Drain(uppers)
+	go helper.Drain(lowers)
+
+	err := helper.CheckEquals(vwaps, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapWithSessionBucketAcrossDaylightSavingTime(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The futures session of the daylight saving time start opens at 18:00 EDT.
+	dates := helper.SliceToChan([]time.Time{
+		time.Date(2024, time.March, 10, 17, 0, 0, 0, newYork),
+		time.Date(2024, time.March, 10, 18, 30, 0, 0, newYork),
+		time.Date(2024, time.March, 10, 19, 0, 0, 0, newYork),
+	})
+
+	prices := helper.SliceToChan([]float64{10, 20, 22})
+	volumes := helper.SliceToChan([]float64{1, 1, 3})
+
+	expected := helper.SliceToChan([]float64{10, 20, 21.5})
+
+	vwap := volume.NewAnchoredVwapWithBucket[float64](helper.NewSessionBucket(newYork, 18*time.Hour))
+
+	vwaps, uppers, lowers := vwap.ComputePricesWithContext(context.Background(), dates, prices, volumes)
+	go helper.Drain(uppers)
+	go helper.Drain(lowers)
+
+	err = helper.CheckEquals(vwaps, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapWithoutVolume(t *testing.T) {
+	prices := helper.SliceToChan([]float64{10, 12})
+	volumes := helper.SliceToChan([]float64{0, 0})
+
+	expected := helper.SliceToChan([]float64{10, 12})
+
+	vwap := volume.NewSessionVwap[float64]()
+
+	vwaps, uppers, lowers := vwap.ComputePricesWithContext(context.Background(), helper.SliceToChan(anchoredVwapDates[:2]), prices, volumes)
+	go helper.Drain(uppers)
+	go helper.Drain(lowers)
+
+	err := helper.CheckEquals(vwaps, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapString(t *testing.T) {
+	expected := "AVWAP(2)"
+	actual := volume.NewSessionVwap[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	if volume.NewSessionVwap[float64]().IdlePeriod() != 0 {
+		t.Fatal("expected no idle period")
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"context"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// category is the category of the indicators in this package.
+const category = "volume"
+
+// closingsVolumesInputs is the inputs of the indicators computed over the closings and volumes.
+var closingsVolumesInputs = []string{helper.InputClosing, helper.InputVolume}
+
+// barVolumesInputs is the inputs of the indicators computed over the highs, lows, closings, and volumes.
+var barVolumesInputs = []string{helper.InputHigh, helper.InputLow, helper.InputClosing, helper.InputVolume}
+
+func init() {
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "ad",
+		Title:    "Accumulation/Distribution",
+		Category: category,
+		Inputs:   barVolumesInputs,
+		Outputs:  []string{"ad"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			ad := NewAd[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{ad.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])}
+			}, ad.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "cmf",
+		Title:      "Chaikin Money Flow",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultCmfPeriod)},
+		Inputs:     barVolumesInputs,
+		Outputs:    []string{"cmf"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			cmf := NewCmfWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{cmf.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])}
+			}, cmf.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "emv",
+		Title:      "Ease of Movement",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultEmvPeriod)},
+		Inputs:     []string{helper.InputHigh, helper.InputLow, helper.InputVolume},
+		Outputs:    []string{"emv"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			emv := NewEmvWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{emv.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, emv.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "fi",
+		Title:      "Force Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultFiPeriod)},
+		Inputs:     closingsVolumesInputs,
+		Outputs:    []string{"fi"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			fi := NewFiWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{fi.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, fi.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "mfi",
+		Title:      "Money Flow Index",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultMfiPeriod)},
+		Inputs:     barVolumesInputs,
+		Outputs:    []string{"mfi"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mfi := NewMfiWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{mfi.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])}
+			}, mfi.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "mfm",
+		Title:    "Money Flow Multiplier",
+		Category: category,
+		Inputs:   []string{helper.InputHigh, helper.InputLow, helper.InputClosing},
+		Outputs:  []string{"mfm"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mfm := NewMfm[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{mfm.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])}
+			}, mfm.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "mfv",
+		Title:    "Money Flow Volume",
+		Category: category,
+		Inputs:   barVolumesInputs,
+		Outputs:  []string{"mfv"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			mfv := NewMfv[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{mfv.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2], inputs[3])}
+			}, mfv.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "nvi",
+		Title:    "Negative Volume Index",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			{
+				Name:        "initial",
+				Description: "Initial NVI value.",
+				Default:     DefaultNviInitial,
+				Min:         0,
+				Max:         math.MaxFloat64,
+			},
+		},
+		Inputs:  closingsVolumesInputs,
+		Outputs: []string{"nvi"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			nvi := NewNvi[float64]()
+			nvi.Initial = params["initial"]
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{nvi.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, nvi.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "obv",
+		Title:    "On-Balance Volume",
+		Category: category,
+		Inputs:   closingsVolumesInputs,
+		Outputs:  []string{"obv"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			obv := NewObv[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{obv.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, obv.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "vpt",
+		Title:    "Volume Price Trend",
+		Category: category,
+		Inputs:   closingsVolumesInputs,
+		Outputs:  []string{"vpt"},
+		Builder: func(map[string]float64) (helper.IndicatorComputeFunc, int) {
+			vpt := NewVpt[float64]()
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{vpt.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, vpt.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:       "vwap",
+		Title:      "Volume Weighted Average Price",
+		Category:   category,
+		Parameters: []helper.IndicatorParameter{helper.NewPeriodParameter("period", DefaultVwapPeriod)},
+		Inputs:     closingsVolumesInputs,
+		Outputs:    []string{"vwap"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			vwap := NewVwapWithPeriod[float64](int(params["period"]))
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				return []<-chan float64{vwap.ComputeWithContext(ctx, inputs[0], inputs[1])}
+			}, vwap.IdlePeriod()
+		},
+	})
+
+	helper.RegisterIndicator(&helper.IndicatorDescriptor{
+		Name:     "kvo",
+		Title:    "Klinger Volume Oscillator",
+		Category: category,
+		Parameters: []helper.IndicatorParameter{
+			helper.NewPeriodParameter("shortPeriod", DefaultKvoShortPeriod),
+			helper.NewPeriodParameter("longPeriod", DefaultKvoLongPeriod),
+			helper.NewPeriodParameter("signalPeriod", DefaultKvoSignalPeriod),
+		},
+		Inputs:  []string{helper.InputHigh, helper.InputLow, helper.InputVolume},
+		Outputs: []string{"kvo", "signal"},
+		Builder: func(params map[string]float64) (helper.IndicatorComputeFunc, int) {
+			kvo := NewKvo[float64]()
+			kvo.ShortEma.Period = int(params["shortPeriod"])
+			kvo.LongEma.Period = int(params["longPeriod"])
+			kvo.SignalEma.Period = int(params["signalPeriod"])
+
+			return func(ctx context.Context, inputs []<-chan float64) []<-chan float64 {
+				kvos, signals := kvo.ComputeWithContext(ctx, inputs[0], inputs[1], inputs[2])
+				return []<-chan float64{kvos, signals}
+			}, kvo.IdlePeriod()
+		},
+	})
+}
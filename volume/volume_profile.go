@@ -0,0 +1,310 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultVolumeProfilePeriod is the default rolling window period of 20.
+	DefaultVolumeProfilePeriod = 20
+
+	// DefaultVolumeProfileLevels is the default number of price levels of 24.
+	DefaultVolumeProfileLevels = 24
+
+	// DefaultVolumeProfileValueArea is the default value area of 70% of the volume.
+	DefaultVolumeProfileValueArea = 0.7
+)
+
+// VolumeProfileLevel is the volume traded within a price level.
+type VolumeProfileLevel[T helper.Float] struct {
+	// Low is the low price of the level.
+	Low T
+
+	// High is the high price of the level.
+	High T
+
+	// Volume is the volume traded within the level.
+	Volume T
+}
+
+// VolumeProfileHistogram is the volume at price histogram of a window along
+// with the levels derived from it.
+type VolumeProfileHistogram[T helper.Float] struct {
+	// Levels is the price levels in ascending price order.
+	Levels []VolumeProfileLevel[T]
+
+	// Poc is the Point of Control, the middle price of the level with the highest volume.
+	Poc T
+
+	// Vah is the Value Area High, the high price of the value area.
+	Vah T
+
+	// Val is the Value Area Low, the low price of the value area.
+	Val T
+
+	// HighVolumeNodes is the middle prices of the levels with more volume than their neighbors.
+	HighVolumeNodes []T
+
+	// LowVolumeNodes is the middle prices of the levels with less volume than their neighbors.
+	LowVolumeNodes []T
+}
+
+// VolumeProfile represents the configuration parameters for calculating the
+// Volume Profile, the distribution of the volume by the price levels over a
+// rolling window of periods, or over an anchored window that restarts at each
+// time bucket. The volume of each period is spread evenly over its range from
+// the low to the high.
+//
+// The Point of Control (POC) is the level with the highest volume. The value
+// area starts at the POC, and grows towards the adjacent level with the
+// higher volume until it has the value area ratio of the total volume. The
+// High Volume Nodes (HVN) and the Low Volume Nodes (LVN) are the levels with
+// more or less volume than both of their neighbors.
+//
+// Example:
+//
+//	profile := volume.NewVolumeProfile[float64]()
+//	pocs, vahs, vals := profile.ComputeWithContext(ctx, dates, highs, lows, volumes)
+type VolumeProfile[T helper.Float] struct {
+	// Period is the rolling window period. It is used when there is no bucket.
+	Period int
+
+	// Bucket is the time bucket that the anchored window restarts at, or nil
+	// for the rolling window.
+	Bucket helper.TimeBucket
+
+	// Levels is the number of price levels.
+	Levels int
+
+	// ValueArea is the ratio of the volume within the value area.
+	ValueArea T
+}
+
+// NewVolumeProfile function initializes a new Volume Profile instance with the default parameters.
+func NewVolumeProfile[T helper.Float]() *VolumeProfile[T] {
+	return NewVolumeProfileWithPeriod[T](DefaultVolumeProfilePeriod)
+}
+
+// NewVolumeProfileWithPeriod function initializes a new Volume Profile
+// instance over the rolling window of the given period.
+func NewVolumeProfileWithPeriod[T helper.Float](period int) *VolumeProfile[T] {
+	return &VolumeProfile[T]{
+		Period:    period,
+		Levels:    DefaultVolumeProfileLevels,
+		ValueArea: DefaultVolumeProfileValueArea,
+	}
+}
+
+// NewAnchoredVolumeProfile function initializes a new Volume Profile instance
+// over the anchored window that restarts at each of the given time buckets,
+// such as each session, or each anchor date of the helper.NewAnchorBucket.
+func NewAnchoredVolumeProfile[T helper.Float](bucket helper.TimeBucket) *VolumeProfile[T] {
+	return &VolumeProfile[T]{
+		Bucket:    bucket,
+		Levels:    DefaultVolumeProfileLevels,
+		ValueArea: DefaultVolumeProfileValueArea,
+	}
+}
+
+// volumeProfileBar is the range and the volume of a period.
+type volumeProfileBar[T helper.Float] struct {
+	High   T
+	Low    T
+	Volume T
+}
+
+// ComputeWithContext function takes channels of dates, highs, lows, and
+// volumes, and computes the POC, the VAH, and the VAL of the window ending at
+// each period.
+func (v *VolumeProfile[T]) ComputeWithContext(ctx context.Context, dates <-chan time.Time, highs, lows, volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
+	histograms := helper.DuplicateWithContext(ctx, v.ComputeHistogramsWithContext(ctx, dates, highs, lows, volumes), 3)
+
+	pocs := helper.MapWithContext(ctx, histograms[0], func(h helper.Dated[*VolumeProfileHistogram[T]]) T {
+		return h.Value.Poc
+	})
+
+	vahs := helper.MapWithContext(ctx, histograms[1], func(h helper.Dated[*VolumeProfileHistogram[T]]) T {
+		return h.Value.Vah
+	})
+
+	vals := helper.MapWithContext(ctx, histograms[2], func(h helper.Dated[*VolumeProfileHistogram[T]]) T {
+		return h.Value.Val
+	})
+
+	return pocs, vahs, vals
+}
+
+// ComputeHistogramsWithContext function takes channels of dates, highs, lows,
+// and volumes, and computes the full histogram of the window ending at each
+// period, such as for the reports, along with its date.
+func (v *VolumeProfile[T]) ComputeHistogramsWithContext(ctx context.Context, dates <-chan time.Time, highs, lows, volumes <-chan T) <-chan helper.Dated[*VolumeProfileHistogram[T]] {
+	bars := helper.Operate4WithContext(ctx, dates, highs, lows, volumes, func(date time.Time, high, low, volume T) helper.Dated[volumeProfileBar[T]] {
+		return helper.NewDated(date, volumeProfileBar[T]{
+			High:   high,
+			Low:    low,
+			Volume: volume,
+		})
+	})
+
+	var windows <-chan helper.Dated[[]volumeProfileBar[T]]
+
+	if v.Bucket != nil {
+		windows = helper.AnchoredWindowWithContext(ctx, bars, v.Bucket)
+	} else {
+		var window []volumeProfileBar[T]
+
+		windows = helper.MapWithContext(ctx, bars, func(bar helper.Dated[volumeProfileBar[T]]) helper.Dated[[]volumeProfileBar[T]] {
+			if len(window) == v.Period {
+				window = window[1:]
+			}
+
+			window = append(window, bar.Value)
+
+			return helper.NewDated(bar.Date, slices.Clone(window))
+		})
+
+		windows = helper.SkipWithContext(ctx, windows, v.IdlePeriod())
+	}
+
+	return helper.AggregateWindowsWithContext(ctx, windows, v.histogram)
+}
+
+// ComputeTpoHistogramsWithContext function takes channels of dates, highs,
+// and lows, and computes the Market Profile histogram of the window ending at
+// each period, where each period counts as one Time Price Opportunity (TPO)
+// instead of its volume.
+func (v *VolumeProfile[T]) ComputeTpoHistogramsWithContext(ctx context.Context, dates <-chan time.Time, highs, lows <-chan T) <-chan helper.Dated[*VolumeProfileHistogram[T]] {
+	highsSplice := helper.DuplicateWithContext(ctx, highs, 2)
+
+	tpos := helper.MapWithContext(ctx, highsSplice[1], func(T) T {
+		return 1
+	})
+
+	return v.ComputeHistogramsWithContext(ctx, dates, highsSplice[0], lows, tpos)
+}
+
+// histogram returns the histogram of the given bars.
+func (v *VolumeProfile[T]) histogram(bars []volumeProfileBar[T]) *VolumeProfileHistogram[T] {
+	low, high := bars[0].Low, bars[0].High
+	for _, bar := range bars[1:] {
+		low = min(low, bar.Low)
+		high = max(high, bar.High)
+	}
+
+	count := max(v.Levels, 1)
+	size := (high - low) / T(count)
+
+	levels := make([]VolumeProfileLevel[T], count)
+	for i := range levels {
+		levels[i].Low = low + T(i)*size
+		levels[i].High = low + T(i+1)*size
+	}
+
+	levels[count-1].High = high
+
+	for _, bar := range bars {
+		// A bar without a range, or all bars without a range, trades at a single level.
+		if bar.High <= bar.Low || size <= 0 {
+			i := count - 1
+			if size > 0 {
+				i = min(int((bar.Low-low)/size), count-1)
+			}
+
+			levels[i].Volume += bar.Volume
+			continue
+		}
+
+		for i := range levels {
+			overlap := min(bar.High, levels[i].High) - max(bar.Low, levels[i].Low)
+			if overlap > 0 {
+				levels[i].Volume += bar.Volume * overlap / (bar.High - bar.Low)
+			}
+		}
+	}
+
+	return newVolumeProfileHistogram(levels, v.ValueArea)
+}
+
+// newVolumeProfileHistogram returns the histogram of the given levels with
+// the POC, the value area, and the volume nodes.
+func newVolumeProfileHistogram[T helper.Float](levels []VolumeProfileLevel[T], valueArea T) *VolumeProfileHistogram[T] {
+	poc := 0
+	total := T(0)
+
+	for i, level := range levels {
+		total += level.Volume
+
+		if level.Volume > levels[poc].Volume {
+			poc = i
+		}
+	}
+
+	// Grow the value area towards the adjacent level with the higher volume.
+	below, above := poc, poc
+	volume := levels[poc].Volume
+
+	for volume < valueArea*total && (below > 0 || above < len(levels)-1) {
+		if above == len(levels)-1 || (below > 0 && levels[below-1].Volume > levels[above+1].Volume) {
+			below--
+			volume += levels[below].Volume
+		} else {
+			above++
+			volume += levels[above].Volume
+		}
+	}
+
+	histogram := &VolumeProfileHistogram[T]{
+		Levels: levels,
+		Poc:    (levels[poc].Low + levels[poc].High) / 2,
+		Vah:    levels[above].High,
+		Val:    levels[below].Low,
+	}
+
+	for i := 1; i < len(levels)-1; i++ {
+		middle := (levels[i].Low + levels[i].High) / 2
+
+		if levels[i].Volume > levels[i-1].Volume && levels[i].Volume > levels[i+1].Volume {
+			histogram.HighVolumeNodes = append(histogram.HighVolumeNodes, middle)
+		}
+
+		if levels[i].Volume < levels[i-1].Volume && levels[i].Volume < levels[i+1].Volume {
+			histogram.LowVolumeNodes = append(histogram.LowVolumeNodes, middle)
+		}
+	}
+
+	return histogram
+}
+
+// IdlePeriod is the initial period that Volume Profile won't yield any results.
+func (v *VolumeProfile[T]) IdlePeriod() int {
+	if v.Bucket != nil {
+		return 0
+	}
+
+	return v.Period - 1
+}
+
+// String is the string representation of the Volume Profile.
+func (v *VolumeProfile[T]) String() string {
+	if v.Bucket != nil {
+		return fmt.Sprintf("VP(%d,%v)", v.Levels, v.ValueArea)
+	}
+
+	return fmt.Sprintf("VP(%d,%d,%v)", v.Period, v.Levels, v.ValueArea)
+}
+
+// Compute wraps ComputeWithContext for backwards compatibility.
+//
+// Deprecated: Use ComputeWithContext instead.
+func (v *VolumeProfile[T]) Compute(dates <-chan time.Time, highs, lows, volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
+	return v.ComputeWithContext(context.Background(), dates, highs, lows, volumes)
+}
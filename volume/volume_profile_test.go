@@ -0,0 +1,202 @@
+// Copyright (c) 2021-2026 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// volumeProfileData is the input and the expected levels of the Volume Profile.
+type volumeProfileData struct {
+	Date   time.Time `format:"2006-01-02"`
+	High   float64
+	Low    float64
+	Volume float64
+	Poc    float64
+	Vah    float64
+	Val    float64
+	TpoPoc float64
+	TpoVah float64
+	TpoVal float64
+}
+
+func TestVolumeProfile(t *testing.T) {
+	input, err := helper.ReadFromCsvFile[volumeProfileData]("testdata/volume_profile.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 7)
+	dates := helper.Map(inputs[0], func(d *volumeProfileData) time.Time { return d.Date })
+	highs := helper.Map(inputs[1], func(d *volumeProfileData) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *volumeProfileData) float64 { return d.Low })
+	volumes := helper.Map(inputs[3], func(d *volumeProfileData) float64 { return d.Volume })
+	expectedPocs := helper.Map(inputs[4], func(d *volumeProfileData) float64 { return d.Poc })
+	expectedVahs := helper.Map(inputs[5], func(d *volumeProfileData) float64 { return d.Vah })
+	expectedVals := helper.Map(inputs[6], func(d *volumeProfileData) float64 { return d.Val })
+
+	profile := volume.NewVolumeProfile[float64]()
+	pocs, vahs, vals := profile.Compute(dates, highs, lows, volumes)
+
+	pocs = helper.RoundDigits(pocs, 2)
+	vahs = helper.RoundDigits(vahs, 2)
+	vals = helper.RoundDigits(vals, 2)
+
+	expectedPocs = helper.Skip(expectedPocs, profile.IdlePeriod())
+	expectedVahs = helper.Skip(expectedVahs, profile.IdlePeriod())
+	expectedVals = helper.Skip(expectedVals, profile.IdlePeriod())
+
+	err = helper.CheckEquals(pocs, expectedPocs, vahs, expectedVahs, vals, expectedVals)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVolumeProfileHistograms(t *testing.T) {
+	input, err := helper.ReadFromCsvFile[volumeProfileData]("testdata/volume_profile.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(input)
+
+	inputs := helper.Duplicate(helper.SliceToChan(rows), 4)
+	dates := helper.Map(inputs[0], func(d *volumeProfileData) time.Time { return d.Date })
+	highs := helper.Map(inputs[1], func(d *volumeProfileData) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *volumeProfileData) float64 { return d.Low })
+	volumes := helper.Map(inputs[3], func(d *volumeProfileData) float64 { return d.Volume })
+
+	profile := volume.NewVolumeProfile[float64]()
+	histograms := helper.ChanToSlice(profile.ComputeHistogramsWithContext(context.Background(), dates, highs, lows, volumes))
+
+	if len(histograms) != len(rows)-profile.IdlePeriod() {
+		t.Fatalf("actual %d histograms expected %d", len(histograms), len(rows)-profile.IdlePeriod())
+	}
+
+	for i, histogram := range histograms {
+		row := rows[i+profile.IdlePeriod()]
+
+		if !histogram.Date.Equal(row.Date) {
+			t.Fatalf("%d actual %v expected %v", i, histogram.Date, row.Date)
+		}
+
+		if len(histogram.Value.Levels) != profile.Levels {
+			t.Fatalf("%d actual %d levels expected %d", i, len(histogram.Value.Levels), profile.Levels)
+		}
+
+		// The volume of the window is spread over the levels.
+		expected := 0.0
+		for _, r := range rows[i : i+profile.Period] {
+			expected += r.Volume
+		}
+
+		actual := 0.0
+		for _, level := range histogram.Value.Levels {
+			actual += level.Volume
+		}
+
+		if math.Abs(actual-expected) > 1e-6*expected {
+			t.Fatalf("%d actual volume %v expected %v", i, actual, expected)
+		}
+
+		if helper.RoundDigit(histogram.Value.Poc, 2) != row.Poc {
+			t.Fatalf("%d actual %v expected %v", i, histogram.Value.Poc, row.Poc)
+		}
+
+		for _, node := range append(histogram.Value.HighVolumeNodes, histogram.Value.LowVolumeNodes...) {
+			if node < histogram.Value.Levels[0].Low || node > histogram.Value.Levels[profile.Levels-1].High {
+				t.Fatalf("%d node %v is out of range", i, node)
+			}
+		}
+	}
+}
+
+func TestAnchoredVolumeProfile(t *testing.T) {
+	input, err := helper.ReadFromCsvFile[volumeProfileData]("testdata/anchored_volume_profile.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 7)
+	dates := helper.Map(inputs[0], func(d *volumeProfileData) time.Time { return d.Date })
+	highs := helper.Map(inputs[1], func(d *volumeProfileData) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *volumeProfileData) float64 { return d.Low })
+	volumes := helper.Map(inputs[3], func(d *volumeProfileData) float64 { return d.Volume })
+	expectedPocs := helper.Map(inputs[4], func(d *volumeProfileData) float64 { return d.Poc })
+	expectedVahs := helper.Map(inputs[5], func(d *volumeProfileData) float64 { return d.Vah })
+	expectedVals := helper.Map(inputs[6], func(d *volumeProfileData) float64 { return d.Val })
+
+	profile := volume.NewAnchoredVolumeProfile[float64](helper.MonthBucket)
+	pocs, vahs, vals := profile.Compute(dates, highs, lows, volumes)
+
+	pocs = helper.RoundDigits(pocs, 2)
+	vahs = helper.RoundDigits(vahs, 2)
+	vals = helper.RoundDigits(vals, 2)
+
+	// The anchored window yields a result for every period.
+	if profile.IdlePeriod() != 0 {
+		t.Fatalf("actual %v expected 0", profile.IdlePeriod())
+	}
+
+	expectedPocs = helper.Skip(expectedPocs, profile.IdlePeriod())
+	expectedVahs = helper.Skip(expectedVahs, profile.IdlePeriod())
+	expectedVals = helper.Skip(expectedVals, profile.IdlePeriod())
+
+	err = helper.CheckEquals(pocs, expectedPocs, vahs, expectedVahs, vals, expectedVals)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredMarketProfile(t *testing.T) {
+	input, err := helper.ReadFromCsvFile[volumeProfileData]("testdata/anchored_volume_profile.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 4)
+	dates := helper.Map(inputs[0], func(d *volumeProfileData) time.Time { return d.Date })
+	highs := helper.Map(inputs[1], func(d *volumeProfileData) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *volumeProfileData) float64 { return d.Low })
+	expected := helper.Map(inputs[3], func(d *volumeProfileData) [3]float64 { return [3]float64{d.TpoPoc, d.TpoVah, d.TpoVal} })
+
+	profile := volume.NewAnchoredVolumeProfile[float64](helper.MonthBucket)
+	histograms := profile.ComputeTpoHistogramsWithContext(context.Background(), dates, highs, lows)
+
+	actual := helper.Map(histograms, func(h helper.Dated[*volume.VolumeProfileHistogram[float64]]) [3]float64 {
+		return [3]float64{
+			helper.RoundDigit(h.Value.Poc, 2),
+			helper.RoundDigit(h.Value.Vah, 2),
+			helper.RoundDigit(h.Value.Val, 2),
+		}
+	})
+
+	err = helper.CheckEquals(actual, helper.Skip(expected, profile.IdlePeriod()))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVolumeProfileString(t *testing.T) {
+	expected := "VP(20,24,0.7)"
+	actual := volume.NewVolumeProfile[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	expected = "VP(24,0.7)"
+	actual = volume.NewAnchoredVolumeProfile[float64](helper.DayBucket).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}